@@ -0,0 +1,140 @@
+package bimap
+
+import "iter"
+
+// BiMap is a bidirectional map that enforces a one-to-one mapping between keys and values.
+// Each key maps to exactly one value, and each value maps back to exactly one key.
+//
+// A BiMap must be created using [New]. It is not safe for concurrent use.
+type BiMap[K, V comparable] struct {
+	forward map[K]V
+	inverse map[V]K
+}
+
+// Pair is a key-value pair stored in a [BiMap].
+type Pair[K, V comparable] struct {
+	Key   K
+	Value V
+}
+
+// New creates an empty BiMap.
+func New[K, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward: make(map[K]V),
+		inverse: make(map[V]K),
+	}
+}
+
+// Len returns the number of pairs in the map.
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+// Put maps key to value, and value to key.
+//
+// To maintain the one-to-one mapping, any existing pair using either the key
+// or the value is removed. The removed pairs are returned, so at most 2 pairs are returned.
+// If the exact pair already exists, or neither key nor value were in use, nil is returned.
+func (m *BiMap[K, V]) Put(key K, value V) []Pair[K, V] {
+	oldValue, keyOK := m.forward[key]
+	oldKey, valueOK := m.inverse[value]
+	if keyOK && valueOK && oldValue == value {
+		// Both exist and are mapped to each other, nothing to do.
+		return nil
+	}
+
+	var displaced []Pair[K, V]
+	if keyOK {
+		delete(m.inverse, oldValue)
+		displaced = append(displaced, Pair[K, V]{key, oldValue})
+	}
+	if valueOK {
+		delete(m.forward, oldKey)
+		displaced = append(displaced, Pair[K, V]{oldKey, value})
+	}
+
+	m.forward[key] = value
+	m.inverse[value] = key
+	return displaced
+}
+
+// GetByKey returns the value mapped to key, and whether the key exists.
+func (m *BiMap[K, V]) GetByKey(key K) (V, bool) {
+	v, ok := m.forward[key]
+	return v, ok
+}
+
+// GetByValue returns the key mapped to value, and whether the value exists.
+func (m *BiMap[K, V]) GetByValue(value V) (K, bool) {
+	k, ok := m.inverse[value]
+	return k, ok
+}
+
+// DeleteByKey deletes the pair using key.
+// It returns the deleted value, and whether the key existed.
+func (m *BiMap[K, V]) DeleteByKey(key K) (V, bool) {
+	v, ok := m.forward[key]
+	if !ok {
+		return v, false
+	}
+
+	delete(m.forward, key)
+	delete(m.inverse, v)
+	return v, true
+}
+
+// DeleteByValue deletes the pair using value.
+// It returns the deleted key, and whether the value existed.
+func (m *BiMap[K, V]) DeleteByValue(value V) (K, bool) {
+	k, ok := m.inverse[value]
+	if !ok {
+		return k, false
+	}
+
+	delete(m.inverse, value)
+	delete(m.forward, k)
+	return k, true
+}
+
+// Inverse returns a view of the map with keys and values swapped.
+// The view shares storage with m, so modifications to either are visible in both.
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{
+		forward: m.inverse,
+		inverse: m.forward,
+	}
+}
+
+// All returns an iterator over all key-value pairs in the map.
+// Since it relies on Go map iteration order, the order of the pairs is non-deterministic.
+func (m *BiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m.forward {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over all keys in the map.
+func (m *BiMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.forward {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over all values in the map.
+func (m *BiMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range m.inverse {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
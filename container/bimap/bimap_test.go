@@ -0,0 +1,255 @@
+package bimap
+
+import (
+	"maps"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestBiMap_Put(t *testing.T) {
+	tests := []struct {
+		name          string
+		initial       []Pair[int, string]
+		key           int
+		value         string
+		wantDisplaced []Pair[int, string]
+		want          map[int]string
+	}{
+		{
+			name:  "empty",
+			key:   1,
+			value: "a",
+			want:  map[int]string{1: "a"},
+		},
+		{
+			name:    "new pair",
+			initial: arr(Pair[int, string]{1, "a"}),
+			key:     2,
+			value:   "b",
+			want:    map[int]string{1: "a", 2: "b"},
+		},
+		{
+			name:    "existing pair",
+			initial: arr(Pair[int, string]{1, "a"}),
+			key:     1,
+			value:   "a",
+			want:    map[int]string{1: "a"},
+		},
+		{
+			name:          "existing key",
+			initial:       arr(Pair[int, string]{1, "a"}),
+			key:           1,
+			value:         "b",
+			wantDisplaced: arr(Pair[int, string]{1, "a"}),
+			want:          map[int]string{1: "b"},
+		},
+		{
+			name:          "existing value",
+			initial:       arr(Pair[int, string]{1, "a"}),
+			key:           2,
+			value:         "a",
+			wantDisplaced: arr(Pair[int, string]{1, "a"}),
+			want:          map[int]string{2: "a"},
+		},
+		{
+			name:          "existing key and value in different pairs",
+			initial:       arr(Pair[int, string]{1, "a"}, Pair[int, string]{2, "b"}, Pair[int, string]{3, "c"}),
+			key:           1,
+			value:         "b",
+			wantDisplaced: arr(Pair[int, string]{1, "a"}, Pair[int, string]{2, "b"}),
+			want:          map[int]string{1: "b", 3: "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New[int, string]()
+			for _, p := range tt.initial {
+				assertEq(t, 0, len(m.Put(p.Key, p.Value)))
+			}
+
+			assertEq(t, tt.wantDisplaced, m.Put(tt.key, tt.value))
+			assertEq(t, tt.want, maps.Collect(m.All()))
+			checkInvariants(t, m)
+		})
+	}
+}
+
+func TestBiMap_Get(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	v, ok := m.GetByKey(1)
+	assertEq(t, true, ok)
+	assertEq(t, "a", v)
+
+	v, ok = m.GetByKey(3)
+	assertEq(t, false, ok)
+	assertEq(t, "", v)
+
+	k, ok := m.GetByValue("b")
+	assertEq(t, true, ok)
+	assertEq(t, 2, k)
+
+	k, ok = m.GetByValue("c")
+	assertEq(t, false, ok)
+	assertEq(t, 0, k)
+}
+
+func TestBiMap_Delete(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+
+	v, ok := m.DeleteByKey(1)
+	assertEq(t, true, ok)
+	assertEq(t, "a", v)
+	checkInvariants(t, m)
+
+	_, ok = m.DeleteByKey(1)
+	assertEq(t, false, ok)
+
+	k, ok := m.DeleteByValue("b")
+	assertEq(t, true, ok)
+	assertEq(t, 2, k)
+	checkInvariants(t, m)
+
+	_, ok = m.DeleteByValue("b")
+	assertEq(t, false, ok)
+
+	assertEq(t, map[int]string{3: "c"}, maps.Collect(m.All()))
+}
+
+func TestBiMap_Inverse(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+
+	inv := m.Inverse()
+	k, ok := inv.GetByKey("a")
+	assertEq(t, true, ok)
+	assertEq(t, 1, k)
+
+	// Modifications to the inverse are visible in the original, and vice versa.
+	inv.Put("b", 2)
+	v, ok := m.GetByKey(2)
+	assertEq(t, true, ok)
+	assertEq(t, "b", v)
+
+	m.DeleteByKey(1)
+	_, ok = inv.GetByKey("a")
+	assertEq(t, false, ok)
+
+	checkInvariants(t, m)
+	checkInvariants(t, inv)
+	assertEq(t, m, inv.Inverse())
+}
+
+func TestBiMap_Iter(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+
+	keys := slices.Sorted(m.Keys())
+	assertEq(t, []int{1, 2, 3}, keys)
+
+	values := slices.Sorted(m.Values())
+	assertEq(t, []string{"a", "b", "c"}, values)
+
+	t.Run("break", func(t *testing.T) {
+		var n int
+		for range m.All() {
+			n++
+			break
+		}
+		for range m.Keys() {
+			n++
+			break
+		}
+		for range m.Values() {
+			n++
+			break
+		}
+		assertEq(t, 3, n)
+	})
+}
+
+func TestBiMap_Random(t *testing.T) {
+	const (
+		ops      = 10000
+		keySpace = 20
+	)
+
+	m := New[int, int]()
+	for range ops {
+		k, v := rand.Intn(keySpace), rand.Intn(keySpace)
+		switch rand.Intn(3) {
+		case 0:
+			beforeLen := m.Len()
+			oldV, existed := m.GetByKey(k)
+			existed = existed && oldV == v
+
+			displaced := m.Put(k, v)
+			for _, p := range displaced {
+				if p.Key != k && p.Value != v {
+					t.Fatalf("Put(%v, %v) displaced unrelated pair %v", k, v, p)
+				}
+			}
+
+			gotV, _ := m.GetByKey(k)
+			gotK, _ := m.GetByValue(v)
+			assertEq(t, v, gotV)
+			assertEq(t, k, gotK)
+			if existed {
+				assertEq(t, beforeLen, m.Len())
+			} else {
+				assertEq(t, beforeLen+1-len(displaced), m.Len())
+			}
+		case 1:
+			m.DeleteByKey(k)
+		case 2:
+			m.DeleteByValue(v)
+		}
+		checkInvariants(t, m)
+	}
+}
+
+// checkInvariants verifies that the forward and inverse maps are consistent.
+func checkInvariants[K, V comparable](t testing.TB, m *BiMap[K, V]) {
+	t.Helper()
+
+	if len(m.forward) != len(m.inverse) {
+		t.Fatalf("forward has %v entries, inverse has %v entries", len(m.forward), len(m.inverse))
+	}
+	for k, v := range m.forward {
+		invK, ok := m.inverse[v]
+		if !ok {
+			t.Fatalf("forward %v -> %v missing inverse entry", k, v)
+		}
+		if invK != k {
+			t.Fatalf("forward %v -> %v has inverse %v -> %v", k, v, v, invK)
+		}
+	}
+}
+
+func assertEq(t testing.TB, want any, got any) {
+	t.Helper()
+
+	if reflect.DeepEqual(want, got) {
+		return
+	}
+
+	t.Fatalf(`assertEq failed, got:
+%+v
+-- want --
+%+v
+`, got, want)
+}
+
+func arr[T any](vs ...T) []T {
+	return vs
+}
@@ -0,0 +1,3 @@
+// Package bimap implements a bidirectional map with a one-to-one mapping
+// between keys and values.
+package bimap
@@ -0,0 +1,3 @@
+module go.prashantv.com/container/bimap
+
+go 1.24
@@ -0,0 +1,169 @@
+package cowset
+
+import (
+	"iter"
+	"sync"
+	"sync/atomic"
+
+	"go.prashantv.com/container/set"
+)
+
+// Set is a copy-on-write set that is safe for concurrent use.
+//
+// Reads are lock-free, and operate on the latest published snapshot.
+// Writes are serialized, and copy the whole set, so they are O(n).
+// It is intended for sets that are read frequently and rarely modified.
+//
+// The zero value is an empty set ready to use.
+type Set[T comparable] struct {
+	mu      sync.Mutex // serializes writers.
+	current atomic.Pointer[set.Set[T]]
+}
+
+// New creates a set with items.
+func New[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{}
+	initial := set.New(items...)
+	s.current.Store(&initial)
+	return s
+}
+
+// Snapshot returns an immutable view of the set's current items.
+// Later writes to the set are not visible in the snapshot.
+func (s *Set[T]) Snapshot() Snapshot[T] {
+	return Snapshot[T]{s.load()}
+}
+
+// Contains returns if the set contains the specified item.
+func (s *Set[T]) Contains(item T) bool {
+	return s.load().Contains(item)
+}
+
+// Len returns the number of items in the set.
+func (s *Set[T]) Len() int {
+	return len(s.load())
+}
+
+// Iter returns an iterator over all items in the set.
+// The iterator uses the snapshot at the time Iter is called,
+// so it is unaffected by concurrent writes.
+func (s *Set[T]) Iter() iter.Seq[T] {
+	return s.load().Iter()
+}
+
+// Insert inserts the item into the set.
+func (s *Set[T]) Insert(item T) {
+	s.InsertUnique(item)
+}
+
+// InsertUnique inserts the item into the set if the item is not already in the set.
+// It returns true if the item did not previously exist, and was inserted.
+func (s *Set[T]) InsertUnique(item T) bool {
+	var inserted bool
+	s.update(func(cur set.Set[T]) set.Set[T] {
+		if cur.Contains(item) {
+			// Avoid copying if there's no change.
+			return nil
+		}
+
+		inserted = true
+		next := cur.Copy()
+		next.Insert(item)
+		return next
+	})
+	return inserted
+}
+
+// Delete deletes the item from the set.
+func (s *Set[T]) Delete(item T) {
+	s.DeleteExists(item)
+}
+
+// DeleteExists deletes the item from the set if it exists.
+// It returns true if the item was deleted.
+func (s *Set[T]) DeleteExists(item T) bool {
+	var deleted bool
+	s.update(func(cur set.Set[T]) set.Set[T] {
+		if !cur.Contains(item) {
+			// Avoid copying if there's no change.
+			return nil
+		}
+
+		deleted = true
+		next := cur.Copy()
+		next.Delete(item)
+		return next
+	})
+	return deleted
+}
+
+// Update runs fn with a copy of the current set, and publishes the modified copy.
+// Concurrent writes are blocked while fn runs, so all modifications are applied atomically.
+//
+// fn must not retain the set after it returns, and must not call write methods on s.
+func (s *Set[T]) Update(fn func(set.Set[T])) {
+	s.update(func(cur set.Set[T]) set.Set[T] {
+		next := cur.Copy()
+		fn(next)
+		return next
+	})
+}
+
+// update runs fn with the current set under the writer lock,
+// and publishes the returned set if it's non-nil.
+func (s *Set[T]) update(fn func(cur set.Set[T]) set.Set[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if next := fn(s.load()); next != nil {
+		s.current.Store(&next)
+	}
+}
+
+func (s *Set[T]) load() set.Set[T] {
+	if cur := s.current.Load(); cur != nil {
+		return *cur
+	}
+	return nil
+}
+
+// Snapshot is an immutable view of a [Set] at a point in time.
+// It is safe for concurrent use.
+type Snapshot[T comparable] struct {
+	s set.Set[T]
+}
+
+// Contains returns if the snapshot contains the specified item.
+func (s Snapshot[T]) Contains(item T) bool {
+	return s.s.Contains(item)
+}
+
+// ContainsAll returns if all the items exist in the snapshot.
+func (s Snapshot[T]) ContainsAll(items []T) bool {
+	return s.s.ContainsAll(items)
+}
+
+// ContainsAny returns true if any of the items exist in the snapshot.
+func (s Snapshot[T]) ContainsAny(items []T) bool {
+	return s.s.ContainsAny(items)
+}
+
+// Len returns the number of items in the snapshot.
+func (s Snapshot[T]) Len() int {
+	return len(s.s)
+}
+
+// Iter returns an iterator over all items in the snapshot.
+func (s Snapshot[T]) Iter() iter.Seq[T] {
+	return s.s.Iter()
+}
+
+// Unordered returns an unordered slice of the items in the snapshot.
+func (s Snapshot[T]) Unordered() []T {
+	return s.s.Unordered()
+}
+
+// Copy returns a mutable copy of the items in the snapshot.
+func (s Snapshot[T]) Copy() set.Set[T] {
+	return s.s.Copy()
+}
@@ -0,0 +1,168 @@
+package cowset
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+
+	"go.prashantv.com/container/set"
+)
+
+func TestSet_ZeroValue(t *testing.T) {
+	var s Set[string]
+	assertEq(t, 0, s.Len())
+	assertEq(t, false, s.Contains("a"))
+	assertEq(t, 0, len(slices.Collect(s.Iter())))
+	assertEq(t, 0, s.Snapshot().Len())
+	assertEq(t, false, s.DeleteExists("a"))
+
+	s.Insert("a")
+	assertEq(t, true, s.Contains("a"))
+	assertEq(t, 1, s.Len())
+}
+
+func TestSet_InsertDelete(t *testing.T) {
+	s := New("a")
+	assertEq(t, true, s.Contains("a"))
+
+	assertEq(t, true, s.InsertUnique("b"))
+	assertEq(t, false, s.InsertUnique("b"))
+	s.Insert("c")
+	assertEq(t, []string{"a", "b", "c"}, slices.Sorted(s.Iter()))
+
+	assertEq(t, true, s.DeleteExists("b"))
+	assertEq(t, false, s.DeleteExists("b"))
+	s.Delete("c")
+	assertEq(t, []string{"a"}, slices.Sorted(s.Iter()))
+}
+
+func TestSet_NoChangeDoesNotPublish(t *testing.T) {
+	s := New("a")
+	before := s.current.Load()
+
+	s.Insert("a")
+	s.Delete("b")
+	assertEq(t, true, before == s.current.Load())
+
+	s.Insert("b")
+	assertEq(t, false, before == s.current.Load())
+}
+
+func TestSet_Update(t *testing.T) {
+	s := New("a", "b")
+	s.Update(func(cur set.Set[string]) {
+		cur.Delete("a")
+		cur.Insert("c")
+		cur.Insert("d")
+	})
+	assertEq(t, []string{"b", "c", "d"}, slices.Sorted(s.Iter()))
+}
+
+func TestSet_Snapshot(t *testing.T) {
+	s := New("a", "b")
+	snap := s.Snapshot()
+
+	s.Insert("c")
+	s.Delete("a")
+
+	// Snapshot is unaffected by later writes.
+	assertEq(t, 2, snap.Len())
+	assertEq(t, true, snap.Contains("a"))
+	assertEq(t, false, snap.Contains("c"))
+	assertEq(t, true, snap.ContainsAll([]string{"a", "b"}))
+	assertEq(t, true, snap.ContainsAny([]string{"c", "b"}))
+	assertEq(t, []string{"a", "b"}, slices.Sorted(snap.Iter()))
+
+	unordered := snap.Unordered()
+	slices.Sort(unordered)
+	assertEq(t, []string{"a", "b"}, unordered)
+
+	// Modifying a copy does not modify the snapshot.
+	copied := snap.Copy()
+	copied.Insert("z")
+	assertEq(t, false, snap.Contains("z"))
+	assertEq(t, false, s.Contains("z"))
+}
+
+func TestSet_Iter_UsesSnapshot(t *testing.T) {
+	s := New("a", "b", "c")
+
+	var got []string
+	for item := range s.Iter() {
+		got = append(got, item)
+		s.Insert(item + item)
+	}
+	slices.Sort(got)
+	assertEq(t, []string{"a", "b", "c"}, got)
+	assertEq(t, 6, s.Len())
+}
+
+func TestSet_Concurrent(t *testing.T) {
+	const (
+		writers = 10
+		readers = 10
+		items   = 100
+	)
+
+	var s Set[int]
+
+	var wg sync.WaitGroup
+	for w := range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range items {
+				s.Insert(w*items + i)
+			}
+		}()
+	}
+
+	stop := make(chan struct{})
+	var readersWG sync.WaitGroup
+	for range readers {
+		readersWG.Add(1)
+		go func() {
+			defer readersWG.Done()
+
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+
+				// Snapshots are never partially updated.
+				snap := s.Snapshot()
+				var n int
+				for range snap.Iter() {
+					n++
+				}
+				if n != snap.Len() {
+					t.Errorf("snapshot iterated %v items, but has Len %v", n, snap.Len())
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(stop)
+	readersWG.Wait()
+
+	assertEq(t, writers*items, s.Len())
+}
+
+func assertEq(t testing.TB, want any, got any) {
+	t.Helper()
+
+	if reflect.DeepEqual(want, got) {
+		return
+	}
+
+	t.Fatalf(`assertEq failed, got:
+%+v
+-- want --
+%+v
+`, got, want)
+}
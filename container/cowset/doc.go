@@ -0,0 +1,5 @@
+// Package cowset implements a copy-on-write set for read-mostly data.
+//
+// Reads are lock-free against an immutable snapshot, while writes copy the set,
+// modify the copy and publish it atomically.
+package cowset
@@ -0,0 +1,5 @@
+module go.prashantv.com/container/cowset
+
+go 1.24
+
+require go.prashantv.com/container/set v0.1.0
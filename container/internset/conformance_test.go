@@ -0,0 +1,17 @@
+package internset_test
+
+import (
+	"strconv"
+	"testing"
+
+	"go.prashantv.com/container/internset"
+	"go.prashantv.com/container/set/settest"
+)
+
+func TestConformance(t *testing.T) {
+	settest.Run(t, internset.New, strconv.Itoa)
+}
+
+func FuzzConformance(f *testing.F) {
+	settest.Fuzz(f, internset.New, strconv.Itoa)
+}
@@ -0,0 +1,5 @@
+// Package internset implements a set of interned strings using the [unique] package.
+//
+// Interning shares the memory for equal strings across all sets,
+// and makes comparisons a pointer comparison rather than a string comparison.
+package internset
@@ -0,0 +1,250 @@
+package internset
+
+import (
+	"iter"
+	"unique"
+
+	"go.prashantv.com/container/set"
+)
+
+// Set implements set operations for strings, where strings are interned on insert.
+// Items are stored as [unique.Handle] values, so equal strings across all sets share memory.
+//
+// Methods match [set.Set], so it can be used as a replacement.
+// It is not safe for concurrent use.
+//
+// The zero value is an empty set ready to use.
+type Set struct {
+	items map[unique.Handle[string]]struct{}
+}
+
+// New creates a set with items.
+func New(items ...string) *Set {
+	s := &Set{
+		items: make(map[unique.Handle[string]]struct{}, len(items)),
+	}
+	for _, item := range items {
+		s.Insert(item)
+	}
+	return s
+}
+
+// FromSet creates a set with the items in other.
+func FromSet(other set.Set[string]) *Set {
+	s := &Set{
+		items: make(map[unique.Handle[string]]struct{}, len(other)),
+	}
+	s.InsertSeq(other.Iter())
+	return s
+}
+
+// ToSet returns a [set.Set] with the items in s.
+// The strings in the returned set share memory with the interned strings.
+func (s *Set) ToSet() set.Set[string] {
+	other := make(set.Set[string], len(s.items))
+	for h := range s.items {
+		other.Insert(h.Value())
+	}
+	return other
+}
+
+// Len returns the number of items in the set.
+func (s *Set) Len() int {
+	return len(s.items)
+}
+
+// Contains returns if the set contains the specified item.
+func (s *Set) Contains(item string) bool {
+	return s.ContainsHandle(unique.Make(item))
+}
+
+// ContainsHandle returns if the set contains the specified interned item.
+func (s *Set) ContainsHandle(h unique.Handle[string]) bool {
+	_, ok := s.items[h]
+	return ok
+}
+
+// ContainsAll returns if all the items exist in the set.
+func (s *Set) ContainsAll(items []string) bool {
+	for _, item := range items {
+		if !s.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny returns true if any of the items exist in the set.
+// If no items are specified, it returns true, matching [set.Set].
+func (s *Set) ContainsAny(items []string) bool {
+	if len(items) == 0 {
+		return true
+	}
+
+	for _, item := range items {
+		if s.Contains(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Copy returns a new set with the same items.
+func (s *Set) Copy() *Set {
+	clone := &Set{
+		items: make(map[unique.Handle[string]]struct{}, len(s.items)),
+	}
+	for h := range s.items {
+		clone.items[h] = struct{}{}
+	}
+	return clone
+}
+
+// Insert interns the item and inserts it into the set, overwriting any existing items.
+func (s *Set) Insert(item string) {
+	s.InsertHandle(unique.Make(item))
+}
+
+// InsertHandle inserts the interned item into the set, overwriting any existing items.
+func (s *Set) InsertHandle(h unique.Handle[string]) {
+	if s.items == nil {
+		s.items = make(map[unique.Handle[string]]struct{})
+	}
+	s.items[h] = struct{}{}
+}
+
+// InsertUnique inserts the item into the set if the item is not already in the set.
+// It returns true if the item did not previously exist, and was inserted.
+func (s *Set) InsertUnique(item string) bool {
+	h := unique.Make(item)
+	if s.ContainsHandle(h) {
+		return false
+	}
+
+	s.InsertHandle(h)
+	return true
+}
+
+// InsertSeq inserts all values from seq into the set, overwriting any existing items.
+func (s *Set) InsertSeq(seq iter.Seq[string]) {
+	for item := range seq {
+		s.Insert(item)
+	}
+}
+
+// Intersect returns a set that only contains items that are in both sets.
+func (s *Set) Intersect(other *Set) *Set {
+	intersect := &Set{
+		items: make(map[unique.Handle[string]]struct{}),
+	}
+	for h := range s.items {
+		if other.ContainsHandle(h) {
+			intersect.items[h] = struct{}{}
+		}
+	}
+	return intersect
+}
+
+// Delete deletes the item from the set.
+func (s *Set) Delete(item string) {
+	delete(s.items, unique.Make(item))
+}
+
+// DeleteExists deletes the item from the set if it exists.
+// It returns true if the item was deleted.
+func (s *Set) DeleteExists(item string) bool {
+	h := unique.Make(item)
+	if !s.ContainsHandle(h) {
+		return false
+	}
+
+	delete(s.items, h)
+	return true
+}
+
+// Equals returns if the two sets are equal.
+func (s *Set) Equals(other *Set) bool {
+	if len(s.items) != len(other.items) {
+		return false
+	}
+	return s.SubsetOf(other)
+}
+
+// SubsetOf returns if other contains all elements in s.
+func (s *Set) SubsetOf(other *Set) bool {
+	for h := range s.items {
+		if !other.ContainsHandle(h) {
+			return false
+		}
+	}
+	return true
+}
+
+// SupersetOf returns if s contains all elements in other.
+func (s *Set) SupersetOf(other *Set) bool {
+	return other.SubsetOf(s)
+}
+
+// Unordered returns an unordered set of values in the set.
+// Since it relies on Go map iteration order, the order of the values is non-deterministic.
+func (s *Set) Unordered() []string {
+	unordered := make([]string, 0, len(s.items))
+	for h := range s.items {
+		unordered = append(unordered, h.Value())
+	}
+	return unordered
+}
+
+// Union returns a set with elements from both sets.
+func (s *Set) Union(other *Set) *Set {
+	union := &Set{
+		items: make(map[unique.Handle[string]]struct{}, max(len(s.items), len(other.items))),
+	}
+	for h := range s.items {
+		union.items[h] = struct{}{}
+	}
+	for h := range other.items {
+		union.items[h] = struct{}{}
+	}
+	return union
+}
+
+// Iter returns an iterator over all items in the set.
+func (s *Set) Iter() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for h := range s.items {
+			if !yield(h.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Handles returns an iterator over all interned items in the set.
+func (s *Set) Handles() iter.Seq[unique.Handle[string]] {
+	return func(yield func(unique.Handle[string]) bool) {
+		for h := range s.items {
+			if !yield(h) {
+				return
+			}
+		}
+	}
+}
+
+// SavedBytes estimates the bytes of string data saved by interning across sets,
+// compared to each set holding its own copy of every string.
+// It only accounts for string data, not the overhead of the sets themselves.
+func SavedBytes(sets ...*Set) int {
+	counts := make(map[unique.Handle[string]]int)
+	for _, s := range sets {
+		for h := range s.items {
+			counts[h]++
+		}
+	}
+
+	var saved int
+	for h, count := range counts {
+		saved += (count - 1) * len(h.Value())
+	}
+	return saved
+}
@@ -0,0 +1,101 @@
+package internset
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"unique"
+	"unsafe"
+
+	"go.prashantv.com/container/set"
+)
+
+func TestSet_ZeroValue(t *testing.T) {
+	var s Set
+	assertEq(t, 0, s.Len())
+	assertEq(t, false, s.Contains("a"))
+	assertEq(t, false, s.DeleteExists("a"))
+
+	assertEq(t, true, s.InsertUnique("a"))
+	assertEq(t, true, s.Contains("a"))
+}
+
+func TestSet_Interned(t *testing.T) {
+	// Build strings dynamically so they don't share memory as constants.
+	a := New(strings.Repeat("x", 10))
+	b := New(strings.Repeat("x", 10))
+
+	aItems, bItems := a.Unordered(), b.Unordered()
+	assertEq(t, true, unsafe.StringData(aItems[0]) == unsafe.StringData(bItems[0]))
+
+	h := unique.Make("xxxxxxxxxx")
+	assertEq(t, true, a.ContainsHandle(h))
+	assertEq(t, []unique.Handle[string]{h}, slices.Collect(b.Handles()))
+}
+
+func TestSet_InsertHandle(t *testing.T) {
+	var s Set
+	s.InsertHandle(unique.Make("a"))
+	assertEq(t, true, s.Contains("a"))
+	assertEq(t, false, s.InsertUnique("a"))
+}
+
+func TestSet_Convert(t *testing.T) {
+	orig := set.New("a", "b", "c")
+	s := FromSet(orig)
+	assertEq(t, 3, s.Len())
+	assertEq(t, true, s.ContainsAll([]string{"a", "b", "c"}))
+
+	s.Delete("a")
+	assertEq(t, true, orig.Contains("a"))
+
+	assertEq(t, set.New("b", "c"), s.ToSet())
+}
+
+func TestSavedBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		sets [][]string
+		want int
+	}{
+		{
+			name: "no sets",
+			want: 0,
+		},
+		{
+			name: "single set",
+			sets: [][]string{{"abc", "de"}},
+			want: 0,
+		},
+		{
+			name: "shared strings",
+			sets: [][]string{{"abc", "de"}, {"abc", "f"}, {"abc", "de"}},
+			want: 2*3 + 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sets []*Set
+			for _, items := range tt.sets {
+				sets = append(sets, New(items...))
+			}
+			assertEq(t, tt.want, SavedBytes(sets...))
+		})
+	}
+}
+
+func assertEq(t testing.TB, want any, got any) {
+	t.Helper()
+
+	if reflect.DeepEqual(want, got) {
+		return
+	}
+
+	t.Fatalf(`assertEq failed, got:
+%+v
+-- want --
+%+v
+`, got, want)
+}
@@ -0,0 +1,4 @@
+// Package lruset implements a capacity-bounded set that evicts the least recently used item.
+//
+// It is useful for deduplication windows, where only recently seen items need to be tracked.
+package lruset
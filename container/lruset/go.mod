@@ -0,0 +1,3 @@
+module go.prashantv.com/container/lruset
+
+go 1.24
@@ -0,0 +1,179 @@
+package lruset
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Set is a set with a fixed capacity. When an insert exceeds the capacity,
+// the least recently inserted or touched item is evicted.
+//
+// A Set must be created using [New]. It is not safe for concurrent use.
+type Set[T comparable] struct {
+	// OnEvict is called with each item evicted due to capacity.
+	// It is not called for items removed using Delete.
+	OnEvict func(item T)
+
+	// TouchOnContains controls whether Contains marks a found item as recently used.
+	TouchOnContains bool
+
+	capacity int
+	items    map[T]*node[T]
+
+	// root is the sentinel of a circular doubly-linked list,
+	// root.next is the most recently used, and root.prev is the least recently used.
+	root node[T]
+}
+
+type node[T comparable] struct {
+	prev, next *node[T]
+	item       T
+}
+
+// New creates an empty set that holds at most capacity items.
+// It panics if capacity is not positive.
+func New[T comparable](capacity int) *Set[T] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("lruset: invalid capacity %d", capacity))
+	}
+
+	s := &Set[T]{
+		capacity: capacity,
+		items:    make(map[T]*node[T], capacity),
+	}
+	s.root.next = &s.root
+	s.root.prev = &s.root
+	return s
+}
+
+// Len returns the number of items in the set.
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Cap returns the maximum number of items in the set.
+func (s *Set[T]) Cap() int {
+	return s.capacity
+}
+
+// Contains returns if the set contains the specified item.
+// If TouchOnContains is set, a found item is marked as recently used.
+func (s *Set[T]) Contains(item T) bool {
+	n, ok := s.items[item]
+	if ok && s.TouchOnContains {
+		s.moveToFront(n)
+	}
+	return ok
+}
+
+// Insert inserts the item into the set, marking it as recently used.
+// If the set is full, the least recently used item is evicted.
+func (s *Set[T]) Insert(item T) {
+	s.InsertUnique(item)
+}
+
+// InsertUnique inserts the item into the set if the item is not already in the set.
+// An existing item is marked as recently used, but is not considered inserted.
+//
+// It returns true if the item did not previously exist, and was inserted.
+// If the insert evicted an item, the evicted item and true are also returned.
+func (s *Set[T]) InsertUnique(item T) (inserted bool, evicted T, didEvict bool) {
+	if n, ok := s.items[item]; ok {
+		s.moveToFront(n)
+		return false, evicted, false
+	}
+
+	var n *node[T]
+	if len(s.items) >= s.capacity {
+		// Reuse the evicted node for the new item.
+		n = s.root.prev
+		s.unlink(n)
+		delete(s.items, n.item)
+		evicted, didEvict = n.item, true
+	} else {
+		n = &node[T]{}
+	}
+
+	n.item = item
+	s.items[item] = n
+	s.pushFront(n)
+
+	if didEvict && s.OnEvict != nil {
+		s.OnEvict(evicted)
+	}
+	return true, evicted, didEvict
+}
+
+// Touch marks the item as recently used.
+// It returns true if the item exists in the set.
+func (s *Set[T]) Touch(item T) bool {
+	n, ok := s.items[item]
+	if ok {
+		s.moveToFront(n)
+	}
+	return ok
+}
+
+// Delete deletes the item from the set.
+func (s *Set[T]) Delete(item T) {
+	s.DeleteExists(item)
+}
+
+// DeleteExists deletes the item from the set if it exists.
+// It returns true if the item was deleted.
+func (s *Set[T]) DeleteExists(item T) bool {
+	n, ok := s.items[item]
+	if !ok {
+		return false
+	}
+
+	s.unlink(n)
+	delete(s.items, item)
+	return true
+}
+
+// Oldest returns the least recently used item, which is the next item to be evicted.
+// It returns false if the set is empty.
+func (s *Set[T]) Oldest() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.root.prev.item, true
+}
+
+// Iter returns an iterator over all items in the set,
+// from the most recently used to the least recently used.
+//
+// The set must not be modified during iteration.
+func (s *Set[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := s.root.next; n != &s.root; n = n.next {
+			if !yield(n.item) {
+				return
+			}
+		}
+	}
+}
+
+func (s *Set[T]) moveToFront(n *node[T]) {
+	if s.root.next == n {
+		return
+	}
+	s.unlink(n)
+	s.pushFront(n)
+}
+
+func (s *Set[T]) pushFront(n *node[T]) {
+	n.prev = &s.root
+	n.next = s.root.next
+	n.prev.next = n
+	n.next.prev = n
+}
+
+func (s *Set[T]) unlink(n *node[T]) {
+	n.prev.next = n.next
+	n.next.prev = n.prev
+	n.prev = nil
+	n.next = nil
+}
@@ -0,0 +1,178 @@
+package lruset
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestNew_InvalidCapacity(t *testing.T) {
+	for _, capacity := range []int{-1, 0} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("New(%v) did not panic", capacity)
+				}
+			}()
+			New[string](capacity)
+		}()
+	}
+}
+
+func TestSet_InsertUnique(t *testing.T) {
+	s := New[string](2)
+	assertEq(t, 2, s.Cap())
+
+	inserted, _, didEvict := s.InsertUnique("a")
+	assertEq(t, true, inserted)
+	assertEq(t, false, didEvict)
+
+	inserted, _, didEvict = s.InsertUnique("b")
+	assertEq(t, true, inserted)
+	assertEq(t, false, didEvict)
+
+	inserted, _, didEvict = s.InsertUnique("a")
+	assertEq(t, false, inserted)
+	assertEq(t, false, didEvict)
+
+	// "a" was touched by the duplicate insert, so "b" is evicted.
+	inserted, evicted, didEvict := s.InsertUnique("c")
+	assertEq(t, true, inserted)
+	assertEq(t, true, didEvict)
+	assertEq(t, "b", evicted)
+
+	assertEq(t, 2, s.Len())
+	assertEq(t, []string{"c", "a"}, slices.Collect(s.Iter()))
+}
+
+func TestSet_Insert(t *testing.T) {
+	s := New[int](3)
+	for i := range 10 {
+		s.Insert(i)
+		assertEq(t, min(i+1, 3), s.Len())
+	}
+	assertEq(t, []int{9, 8, 7}, slices.Collect(s.Iter()))
+
+	oldest, ok := s.Oldest()
+	assertEq(t, true, ok)
+	assertEq(t, 7, oldest)
+
+	// Re-inserting an existing item marks it as recently used.
+	s.Insert(7)
+	assertEq(t, []int{7, 9, 8}, slices.Collect(s.Iter()))
+}
+
+func TestSet_Contains(t *testing.T) {
+	tests := []struct {
+		name            string
+		touchOnContains bool
+		wantEvicted     string
+	}{
+		{
+			name:            "no touch",
+			touchOnContains: false,
+			wantEvicted:     "a",
+		},
+		{
+			name:            "touch",
+			touchOnContains: true,
+			wantEvicted:     "b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New[string](2)
+			s.TouchOnContains = tt.touchOnContains
+			s.Insert("a")
+			s.Insert("b")
+
+			assertEq(t, true, s.Contains("a"))
+			assertEq(t, false, s.Contains("c"))
+
+			_, evicted, _ := s.InsertUnique("c")
+			assertEq(t, tt.wantEvicted, evicted)
+		})
+	}
+}
+
+func TestSet_Touch(t *testing.T) {
+	s := New[string](2)
+	s.Insert("a")
+	s.Insert("b")
+
+	assertEq(t, true, s.Touch("a"))
+	assertEq(t, false, s.Touch("c"))
+
+	_, evicted, _ := s.InsertUnique("c")
+	assertEq(t, "b", evicted)
+}
+
+func TestSet_OnEvict(t *testing.T) {
+	var evicted []int
+	s := New[int](2)
+	s.OnEvict = func(item int) {
+		evicted = append(evicted, item)
+	}
+
+	for i := range 5 {
+		s.Insert(i)
+	}
+	s.Delete(4)
+	assertEq(t, []int{0, 1, 2}, evicted)
+}
+
+func TestSet_Delete(t *testing.T) {
+	s := New[string](3)
+	s.Insert("a")
+	s.Insert("b")
+	s.Insert("c")
+
+	assertEq(t, true, s.DeleteExists("b"))
+	assertEq(t, false, s.DeleteExists("b"))
+	assertEq(t, false, s.Contains("b"))
+
+	s.Delete("a")
+	assertEq(t, []string{"c"}, slices.Collect(s.Iter()))
+
+	s.Delete("c")
+	assertEq(t, 0, s.Len())
+	_, ok := s.Oldest()
+	assertEq(t, false, ok)
+
+	// Capacity freed by deletes is available without eviction.
+	for _, item := range []string{"d", "e", "f"} {
+		_, _, didEvict := s.InsertUnique(item)
+		assertEq(t, false, didEvict)
+	}
+}
+
+func TestSet_Iter_Break(t *testing.T) {
+	s := New[int](5)
+	for i := range 5 {
+		s.Insert(i)
+	}
+
+	var got []int
+	for item := range s.Iter() {
+		got = append(got, item)
+		if len(got) == 2 {
+			break
+		}
+	}
+	assertEq(t, []int{4, 3}, got)
+}
+
+func assertEq(t testing.TB, want any, got any) {
+	t.Helper()
+
+	if reflect.DeepEqual(want, got) {
+		return
+	}
+
+	t.Fatalf(`assertEq failed, got:
+%+v
+-- want --
+%+v
+`, got, want)
+}
@@ -0,0 +1,177 @@
+package set
+
+import (
+	"cmp"
+	"iter"
+)
+
+// PowerSet returns an iterator over all subsets of s, including the empty set and s itself.
+// Subsets are yielded in increasing size. Each yielded set is newly allocated.
+//
+// Since it relies on Go map iteration order, the order of the subsets is non-deterministic.
+// Use `PowerSetOrdered` when deterministic output is required.
+func PowerSet[T comparable](s Set[T]) iter.Seq[Set[T]] {
+	return powerSet(s.Unordered())
+}
+
+// PowerSetOrdered is the same as PowerSet, but subsets of the same size
+// are yielded in lexicographic order of their sorted items.
+func PowerSetOrdered[T cmp.Ordered](s Set[T]) iter.Seq[Set[T]] {
+	return powerSet(Ordered(s))
+}
+
+// Combinations returns an iterator over all subsets of s with k items.
+// If k is negative, or larger than the size of s, no subsets are yielded.
+// Each yielded set is newly allocated.
+//
+// Since it relies on Go map iteration order, the order of the subsets is non-deterministic.
+// Use `CombinationsOrdered` when deterministic output is required.
+func Combinations[T comparable](s Set[T], k int) iter.Seq[Set[T]] {
+	return combinations(s.Unordered(), k)
+}
+
+// CombinationsOrdered is the same as Combinations, but subsets are yielded
+// in lexicographic order of their sorted items.
+func CombinationsOrdered[T cmp.Ordered](s Set[T], k int) iter.Seq[Set[T]] {
+	return combinations(Ordered(s), k)
+}
+
+// Product returns an iterator over all pairs in the cartesian product of a and b.
+//
+// Since it relies on Go map iteration order, the order of the pairs is non-deterministic.
+// Use `ProductOrdered` when deterministic output is required.
+func Product[A, B comparable](a Set[A], b Set[B]) iter.Seq2[A, B] {
+	return product(a.Unordered(), b.Unordered())
+}
+
+// ProductOrdered is the same as Product, but pairs are yielded in lexicographic order.
+func ProductOrdered[A, B cmp.Ordered](a Set[A], b Set[B]) iter.Seq2[A, B] {
+	return product(Ordered(a), Ordered(b))
+}
+
+// ProductN returns an iterator over all tuples in the cartesian product of sets,
+// where the i-th item of each tuple is from sets[i].
+// Each yielded tuple is newly allocated.
+//
+// If no sets are specified, a single empty tuple is yielded.
+// If any set is empty, no tuples are yielded.
+//
+// Since it relies on Go map iteration order, the order of the tuples is non-deterministic.
+// Use `ProductNOrdered` when deterministic output is required.
+func ProductN[T comparable](sets ...Set[T]) iter.Seq[[]T] {
+	items := make([][]T, len(sets))
+	for i, s := range sets {
+		items[i] = s.Unordered()
+	}
+	return productN(items)
+}
+
+// ProductNOrdered is the same as ProductN, but tuples are yielded in lexicographic order.
+func ProductNOrdered[T cmp.Ordered](sets ...Set[T]) iter.Seq[[]T] {
+	items := make([][]T, len(sets))
+	for i, s := range sets {
+		items[i] = Ordered(s)
+	}
+	return productN(items)
+}
+
+func powerSet[T comparable](items []T) iter.Seq[Set[T]] {
+	return func(yield func(Set[T]) bool) {
+		for k := 0; k <= len(items); k++ {
+			for subset := range combinations(items, k) {
+				if !yield(subset) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// combinations yields subsets of size k in lexicographic order of indexes into items.
+func combinations[T comparable](items []T, k int) iter.Seq[Set[T]] {
+	return func(yield func(Set[T]) bool) {
+		n := len(items)
+		if k < 0 || k > n {
+			return
+		}
+
+		// indexes is the current combination, as increasing indexes into items.
+		indexes := make([]int, k)
+		for i := range indexes {
+			indexes[i] = i
+		}
+
+		for {
+			subset := make(Set[T], k)
+			for _, idx := range indexes {
+				subset.Insert(items[idx])
+			}
+			if !yield(subset) {
+				return
+			}
+
+			// Find the rightmost index that can be incremented.
+			i := k - 1
+			for i >= 0 && indexes[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+
+			indexes[i]++
+			for j := i + 1; j < k; j++ {
+				indexes[j] = indexes[j-1] + 1
+			}
+		}
+	}
+}
+
+func product[A, B comparable](as []A, bs []B) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		for _, a := range as {
+			for _, b := range bs {
+				if !yield(a, b) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// productN yields tuples in lexicographic order of indexes into items.
+func productN[T comparable](items [][]T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for _, vs := range items {
+			if len(vs) == 0 {
+				return
+			}
+		}
+
+		// indexes is the current tuple, as indexes into each of items.
+		indexes := make([]int, len(items))
+		for {
+			tuple := make([]T, len(items))
+			for i, idx := range indexes {
+				tuple[i] = items[i][idx]
+			}
+			if !yield(tuple) {
+				return
+			}
+
+			// Increment indexes like an odometer, with the last position changing fastest.
+			i := len(indexes) - 1
+			for i >= 0 {
+				indexes[i]++
+				if indexes[i] < len(items[i]) {
+					break
+				}
+				indexes[i] = 0
+				i--
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
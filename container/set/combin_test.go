@@ -0,0 +1,248 @@
+package set
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"testing"
+)
+
+func TestPowerSet(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []string
+		want  [][]string
+	}{
+		{
+			name:  "empty",
+			items: nil,
+			want:  [][]string{{}},
+		},
+		{
+			name:  "single",
+			items: arr("a"),
+			want:  [][]string{{}, {"a"}},
+		},
+		{
+			name:  "multiple",
+			items: arr("c", "a", "b"),
+			want: [][]string{
+				{},
+				{"a"}, {"b"}, {"c"},
+				{"a", "b"}, {"a", "c"}, {"b", "c"},
+				{"a", "b", "c"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(tt.items...)
+
+			t.Run("PowerSetOrdered", func(t *testing.T) {
+				assertEq(t, tt.want, collectOrdered(PowerSetOrdered(s)))
+			})
+
+			t.Run("PowerSet", func(t *testing.T) {
+				got := collectOrdered(PowerSet(s))
+				sortSubsets(got)
+				want := slices.Clone(tt.want)
+				sortSubsets(want)
+				assertEq(t, want, got)
+			})
+		})
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	s := New(1, 2, 3, 4)
+
+	tests := []struct {
+		k    int
+		want [][]int
+	}{
+		{k: -1, want: nil},
+		{k: 0, want: [][]int{{}}},
+		{k: 1, want: [][]int{{1}, {2}, {3}, {4}}},
+		{k: 2, want: [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}},
+		{k: 3, want: [][]int{{1, 2, 3}, {1, 2, 4}, {1, 3, 4}, {2, 3, 4}}},
+		{k: 4, want: [][]int{{1, 2, 3, 4}}},
+		{k: 5, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			assertEq(t, tt.want, collectOrdered(CombinationsOrdered(s, tt.k)))
+
+			got := collectOrdered(Combinations(s, tt.k))
+			sortSubsets(got)
+			assertEq(t, tt.want, got)
+		})
+	}
+}
+
+func TestCombinations_Independent(t *testing.T) {
+	// Yielded sets are independent, so they can be retained and modified.
+	var all []Set[int]
+	for subset := range CombinationsOrdered(New(1, 2, 3), 2) {
+		all = append(all, subset)
+	}
+	all[0].Insert(100)
+	assertEq(t, New(1, 2, 100), all[0])
+	assertEq(t, New(1, 3), all[1])
+}
+
+func TestProduct(t *testing.T) {
+	a := New("a", "b")
+	b := New(1, 2, 3)
+
+	type pair struct {
+		A string
+		B int
+	}
+	collect := func(seq iter.Seq2[string, int]) []pair {
+		var pairs []pair
+		for a, b := range seq {
+			pairs = append(pairs, pair{a, b})
+		}
+		return pairs
+	}
+
+	want := []pair{{"a", 1}, {"a", 2}, {"a", 3}, {"b", 1}, {"b", 2}, {"b", 3}}
+	assertEq(t, want, collect(ProductOrdered(a, b)))
+
+	got := collect(Product(a, b))
+	slices.SortFunc(got, func(x, y pair) int {
+		if c := cmp.Compare(x.A, y.A); c != 0 {
+			return c
+		}
+		return cmp.Compare(x.B, y.B)
+	})
+	assertEq(t, want, got)
+
+	assertEq(t, []pair(nil), collect(ProductOrdered(New[string](), b)))
+	assertEq(t, []pair(nil), collect(ProductOrdered(a, New[int]())))
+}
+
+func TestProductN(t *testing.T) {
+	tests := []struct {
+		name string
+		sets [][]int
+		want [][]int
+	}{
+		{
+			name: "no sets",
+			sets: nil,
+			want: [][]int{{}},
+		},
+		{
+			name: "single set",
+			sets: [][]int{{2, 1}},
+			want: [][]int{{1}, {2}},
+		},
+		{
+			name: "empty set",
+			sets: [][]int{{1, 2}, {}, {3}},
+			want: nil,
+		},
+		{
+			name: "multiple sets",
+			sets: [][]int{{1, 2}, {3}, {4, 5}},
+			want: [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sets []Set[int]
+			for _, items := range tt.sets {
+				sets = append(sets, New(items...))
+			}
+
+			assertEq(t, tt.want, slices.Collect(ProductNOrdered(sets...)))
+
+			got := slices.Collect(ProductN(sets...))
+			sortSubsets(got)
+			assertEq(t, tt.want, got)
+		})
+	}
+}
+
+func TestCombinatorics_Break(t *testing.T) {
+	s := New(1, 2, 3, 4)
+
+	var n int
+	for range PowerSet(s) {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+	assertEq(t, 3, n)
+
+	n = 0
+	for range Combinations(s, 2) {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+	assertEq(t, 3, n)
+
+	n = 0
+	for range Product(s, s) {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+	assertEq(t, 3, n)
+
+	n = 0
+	for range ProductN(s, s, s) {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+	assertEq(t, 3, n)
+}
+
+func TestPowerSet_Large(t *testing.T) {
+	// Subsets are produced lazily, so a power set that is too large
+	// to materialize can still be partially consumed.
+	s := make(Set[int])
+	for i := range 100 {
+		s.Insert(i)
+	}
+
+	var n int
+	for subset := range PowerSetOrdered(s) {
+		n++
+		if len(subset) == 2 {
+			break
+		}
+	}
+	assertEq(t, 1+100+1, n)
+}
+
+func collectOrdered[T cmp.Ordered](seq iter.Seq[Set[T]]) [][]T {
+	var all [][]T
+	for s := range seq {
+		all = append(all, Ordered(s))
+	}
+	return all
+}
+
+// sortSubsets sorts subsets by size, and then lexicographically.
+func sortSubsets[T cmp.Ordered](subsets [][]T) {
+	for _, s := range subsets {
+		slices.Sort(s)
+	}
+	slices.SortFunc(subsets, func(a, b []T) int {
+		if c := cmp.Compare(len(a), len(b)); c != 0 {
+			return c
+		}
+		return slices.Compare(a, b)
+	})
+}
@@ -0,0 +1,26 @@
+package set_test
+
+import (
+	"strconv"
+	"testing"
+
+	"go.prashantv.com/container/set"
+	"go.prashantv.com/container/set/settest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		settest.Run(t, set.New[int], identity)
+	})
+	t.Run("string", func(t *testing.T) {
+		settest.Run(t, set.New[string], strconv.Itoa)
+	})
+}
+
+func FuzzConformance(f *testing.F) {
+	settest.Fuzz(f, set.New[int], identity)
+}
+
+func identity(i int) int {
+	return i
+}
@@ -0,0 +1,5 @@
+// Package settest implements conformance tests for set implementations.
+//
+// Any type with the method set of [set.Set] can be verified against
+// the algebraic laws of sets using table tests and fuzz targets.
+package settest
@@ -0,0 +1,357 @@
+package settest
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"go.prashantv.com/container/set"
+)
+
+// Interface is the method set of [set.Set].
+// S is the implementing set type, used by methods that operate on multiple sets.
+type Interface[S any, T comparable] interface {
+	Contains(item T) bool
+	ContainsAll(items []T) bool
+	ContainsAny(items []T) bool
+	Copy() S
+	Insert(item T)
+	InsertUnique(item T) bool
+	InsertSeq(seq iter.Seq[T])
+	Intersect(other S) S
+	Delete(item T)
+	DeleteExists(item T) bool
+	Equals(other S) bool
+	SubsetOf(other S) bool
+	SupersetOf(other S) bool
+	Unordered() []T
+	Union(other S) S
+	Iter() iter.Seq[T]
+}
+
+// set.Set is the reference implementation.
+var _ Interface[set.Set[int], int] = set.Set[int]{}
+
+// lawTests are the table tests, also used to seed the fuzz corpus.
+// Each int is mapped to an item using the caller's elem function.
+var lawTests = []struct {
+	name    string
+	a, b, c []int
+}{
+	{name: "all empty"},
+	{name: "one non-empty", a: []int{1, 2}},
+	{name: "duplicates", a: []int{1, 1, 2}, b: []int{2, 2}, c: []int{1}},
+	{name: "equal", a: []int{1, 2, 3}, b: []int{3, 2, 1}, c: []int{2, 1, 3}},
+	{name: "disjoint", a: []int{1, 2}, b: []int{3, 4}, c: []int{5}},
+	{name: "overlapping", a: []int{1, 2, 3}, b: []int{2, 3, 4}, c: []int{3, 4, 5}},
+	{name: "chain of subsets", a: []int{1}, b: []int{1, 2}, c: []int{1, 2, 3}},
+	{name: "large", a: seq(0, 100), b: seq(50, 150), c: seq(25, 75)},
+}
+
+// Run runs conformance tests for a set implementation.
+//
+// newSet creates a set containing the specified items,
+// and elem maps a non-negative int to an item, where distinct ints must map to distinct items.
+func Run[S Interface[S, T], T comparable](t *testing.T, newSet func(items ...T) S, elem func(int) T) {
+	for _, tt := range lawTests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkLaws(t, newSet, mapInts(tt.a, elem), mapInts(tt.b, elem), mapInts(tt.c, elem))
+		})
+	}
+
+	t.Run("mutations", func(t *testing.T) {
+		// Insert a range, delete every other item, and then re-insert all items.
+		var ops []op
+		for i := range 40 {
+			ops = append(ops, op{insert: true, item: i % 20})
+		}
+		for i := range 20 {
+			ops = append(ops, op{insert: false, item: i * 2})
+		}
+		for i := range 25 {
+			ops = append(ops, op{insert: true, item: i})
+		}
+		checkMutations(t, newSet, elem, ops)
+	})
+}
+
+// Fuzz runs fuzz targets for a set implementation.
+// The arguments are the same as [Run].
+//
+// The fuzz corpus is seeded with the table tests used by [Run].
+func Fuzz[S Interface[S, T], T comparable](f *testing.F, newSet func(items ...T) S, elem func(int) T) {
+	for _, tt := range lawTests {
+		f.Add(intsToBytes(tt.a), intsToBytes(tt.b), intsToBytes(tt.c))
+	}
+
+	f.Fuzz(func(t *testing.T, a, b, c []byte) {
+		checkLaws(t, newSet, bytesToItems(a, elem), bytesToItems(b, elem), bytesToItems(c, elem))
+
+		// Use the high bit of each byte as the operation, so all inputs are valid operations.
+		ops := make([]op, len(a))
+		for i, v := range a {
+			ops[i] = op{insert: v&0x80 == 0, item: int(v & 0x7f)}
+		}
+		checkMutations(t, newSet, elem, ops)
+	})
+}
+
+type op struct {
+	insert bool
+	item   int
+}
+
+func checkLaws[S Interface[S, T], T comparable](t testing.TB, newSet func(items ...T) S, a, b, c []T) {
+	t.Helper()
+
+	sa, sb, sc := newSet(a...), newSet(b...), newSet(c...)
+	ma, mb, mc := newModel(a), newModel(b), newModel(c)
+	all := slices.Concat(a, b, c)
+
+	check := func(name string, got S, want model[T]) {
+		t.Helper()
+		checkContents(t, name, got, want, all)
+	}
+
+	check("a", sa, ma)
+	check("b", sb, mb)
+	check("c", sc, mc)
+
+	// Equals is reflexive, symmetric, and matches the model.
+	for _, s := range []S{sa, sb, sc} {
+		if !s.Equals(s) {
+			t.Fatalf("Equals is not reflexive for %v", s.Unordered())
+		}
+		if !s.Equals(s.Copy()) {
+			t.Fatalf("Equals is false for a copy of %v", s.Unordered())
+		}
+	}
+	reversed := slices.Clone(a)
+	slices.Reverse(reversed)
+	if !sa.Equals(newSet(reversed...)) {
+		t.Fatalf("Equals depends on insertion order for %v", a)
+	}
+	if got, want := sa.Equals(sb), ma.equals(mb); got != want || sb.Equals(sa) != want {
+		t.Fatalf("a.Equals(b) = %v, want %v, a = %v, b = %v", got, want, a, b)
+	}
+
+	// Union and Intersect match the model, and are commutative and associative.
+	check("a.Union(b)", sa.Union(sb), ma.union(mb))
+	check("b.Union(a)", sb.Union(sa), ma.union(mb))
+	check("a.Union(b).Union(c)", sa.Union(sb).Union(sc), ma.union(mb).union(mc))
+	check("a.Union(b.Union(c))", sa.Union(sb.Union(sc)), ma.union(mb).union(mc))
+	check("a.Intersect(b)", sa.Intersect(sb), ma.intersect(mb))
+	check("b.Intersect(a)", sb.Intersect(sa), ma.intersect(mb))
+	check("a.Intersect(b).Intersect(c)", sa.Intersect(sb).Intersect(sc), ma.intersect(mb).intersect(mc))
+	check("a.Intersect(b.Intersect(c))", sa.Intersect(sb.Intersect(sc)), ma.intersect(mb).intersect(mc))
+
+	// Union and Intersect are idempotent.
+	check("a.Union(a)", sa.Union(sa), ma)
+	check("a.Intersect(a)", sa.Intersect(sa), ma)
+
+	// SubsetOf and SupersetOf match the model, and are consistent with each other.
+	sets := []S{sa, sb, sc}
+	models := []model[T]{ma, mb, mc}
+	for i := range sets {
+		for j := range sets {
+			want := models[i].subsetOf(models[j])
+			if got := sets[i].SubsetOf(sets[j]); got != want {
+				t.Fatalf("SubsetOf(%v, %v) = %v, want %v", sets[i].Unordered(), sets[j].Unordered(), got, want)
+			}
+			if got := sets[j].SupersetOf(sets[i]); got != want {
+				t.Fatalf("SupersetOf(%v, %v) = %v, want %v", sets[j].Unordered(), sets[i].Unordered(), got, want)
+			}
+		}
+	}
+
+	// SubsetOf is transitive.
+	for _, x := range sets {
+		for _, y := range sets {
+			for _, z := range sets {
+				if x.SubsetOf(y) && y.SubsetOf(z) && !x.SubsetOf(z) {
+					t.Fatalf("SubsetOf is not transitive for %v, %v, %v", x.Unordered(), y.Unordered(), z.Unordered())
+				}
+			}
+		}
+	}
+	if intersect, union := sa.Intersect(sb), sa.Union(sb); !intersect.SubsetOf(sa) || !sa.SubsetOf(union) {
+		t.Fatalf("expected a.Intersect(b) <= a <= a.Union(b), a = %v, b = %v", a, b)
+	}
+
+	// Operations do not modify their inputs.
+	check("a after operations", sa, ma)
+	check("b after operations", sb, mb)
+	check("c after operations", sc, mc)
+
+	// Copies are independent.
+	copied := sa.Copy()
+	copied.InsertSeq(sb.Iter())
+	check("a after modifying copy", sa, ma)
+	check("copy with b", copied, ma.union(mb))
+}
+
+func checkMutations[S Interface[S, T], T comparable](t testing.TB, newSet func(items ...T) S, elem func(int) T, ops []op) {
+	t.Helper()
+
+	s := newSet()
+	m := newModel[T](nil)
+	var all []T
+	for i, op := range ops {
+		item := elem(op.item)
+		all = append(all, item)
+
+		_, exists := m[item]
+		if op.insert {
+			if got := s.InsertUnique(item); got != !exists {
+				t.Fatalf("op %v: InsertUnique(%v) = %v, want %v", i, item, got, !exists)
+			}
+			m[item] = struct{}{}
+		} else {
+			if got := s.DeleteExists(item); got != exists {
+				t.Fatalf("op %v: DeleteExists(%v) = %v, want %v", i, item, got, exists)
+			}
+			delete(m, item)
+		}
+		checkContents(t, "after mutation", s, m, all)
+	}
+
+	// Insert and Delete are idempotent.
+	for _, item := range all {
+		s.Insert(item)
+		s.Insert(item)
+	}
+	checkContents(t, "after Insert", s, newModel(all), all)
+	for _, item := range all {
+		s.Delete(item)
+		s.Delete(item)
+	}
+	checkContents(t, "after Delete", s, newModel[T](nil), all)
+}
+
+// checkContents verifies s contains exactly the items in want.
+// probe is a list of items that may or may not be in s, to verify Contains.
+func checkContents[S Interface[S, T], T comparable](t testing.TB, name string, s S, want model[T], probe []T) {
+	t.Helper()
+
+	unordered := s.Unordered()
+	if got := newModel(unordered); len(unordered) != len(got) || !got.equals(want) {
+		t.Fatalf("%v: Unordered() = %v, want %v", name, unordered, want.items())
+	}
+
+	var iterated []T
+	for item := range s.Iter() {
+		iterated = append(iterated, item)
+	}
+	if got := newModel(iterated); len(iterated) != len(got) || !got.equals(want) {
+		t.Fatalf("%v: Iter() = %v, want %v", name, iterated, want.items())
+	}
+
+	for _, item := range probe {
+		_, wantContains := want[item]
+		if got := s.Contains(item); got != wantContains {
+			t.Fatalf("%v: Contains(%v) = %v, want %v", name, item, got, wantContains)
+		}
+		if got := s.ContainsAll([]T{item}); got != wantContains {
+			t.Fatalf("%v: ContainsAll(%v) = %v, want %v", name, item, got, wantContains)
+		}
+		if got := s.ContainsAny([]T{item}); got != wantContains {
+			t.Fatalf("%v: ContainsAny(%v) = %v, want %v", name, item, got, wantContains)
+		}
+	}
+
+	if len(probe) > 0 {
+		wantAll := newModel(probe).subsetOf(want)
+		if got := s.ContainsAll(probe); got != wantAll {
+			t.Fatalf("%v: ContainsAll(%v) = %v, want %v", name, probe, got, wantAll)
+		}
+		wantAny := len(newModel(probe).intersect(want)) > 0
+		if got := s.ContainsAny(probe); got != wantAny {
+			t.Fatalf("%v: ContainsAny(%v) = %v, want %v", name, probe, got, wantAny)
+		}
+	}
+}
+
+// model is a minimal set implementation used to verify results.
+type model[T comparable] map[T]struct{}
+
+func newModel[T comparable](items []T) model[T] {
+	m := make(model[T], len(items))
+	for _, item := range items {
+		m[item] = struct{}{}
+	}
+	return m
+}
+
+func (m model[T]) items() []T {
+	items := make([]T, 0, len(m))
+	for item := range m {
+		items = append(items, item)
+	}
+	return items
+}
+
+func (m model[T]) equals(other model[T]) bool {
+	return len(m) == len(other) && m.subsetOf(other)
+}
+
+func (m model[T]) subsetOf(other model[T]) bool {
+	for item := range m {
+		if _, ok := other[item]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (m model[T]) union(other model[T]) model[T] {
+	union := make(model[T], len(m)+len(other))
+	for item := range m {
+		union[item] = struct{}{}
+	}
+	for item := range other {
+		union[item] = struct{}{}
+	}
+	return union
+}
+
+func (m model[T]) intersect(other model[T]) model[T] {
+	intersect := make(model[T])
+	for item := range m {
+		if _, ok := other[item]; ok {
+			intersect[item] = struct{}{}
+		}
+	}
+	return intersect
+}
+
+func mapInts[T any](is []int, elem func(int) T) []T {
+	items := make([]T, len(is))
+	for i, v := range is {
+		items[i] = elem(v)
+	}
+	return items
+}
+
+func bytesToItems[T any](bs []byte, elem func(int) T) []T {
+	items := make([]T, len(bs))
+	for i, b := range bs {
+		items[i] = elem(int(b))
+	}
+	return items
+}
+
+func intsToBytes(is []int) []byte {
+	bs := make([]byte, len(is))
+	for i, v := range is {
+		bs[i] = byte(v)
+	}
+	return bs
+}
+
+func seq(start, end int) []int {
+	is := make([]int, 0, end-start)
+	for i := start; i < end; i++ {
+		is = append(is, i)
+	}
+	return is
+}
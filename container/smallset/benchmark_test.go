@@ -0,0 +1,98 @@
+package smallset
+
+import (
+	"fmt"
+	"testing"
+
+	"go.prashantv.com/container/set"
+)
+
+var (
+	benchSizes = []int{1, 2, 4, 8, 16, 32, 64}
+
+	// Sinks prevent the compiler from optimizing away benchmarked calls.
+	setSink      set.Set[string]
+	smallSetSink *Set[string]
+)
+
+func BenchmarkNew(b *testing.B) {
+	for _, size := range benchSizes {
+		items := benchItems(size)
+
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			b.Run("Set", func(b *testing.B) {
+				b.ReportAllocs()
+				for b.Loop() {
+					setSink = set.New(items...)
+				}
+			})
+
+			b.Run("SmallSet", func(b *testing.B) {
+				b.ReportAllocs()
+				for b.Loop() {
+					smallSetSink = New(items...)
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkContains(b *testing.B) {
+	for _, size := range benchSizes {
+		items := benchItems(size)
+		// Search for every item, and one missing item.
+		search := append([]string{"missing"}, items...)
+
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			b.Run("Set", func(b *testing.B) {
+				s := set.New(items...)
+				for b.Loop() {
+					for _, item := range search {
+						s.Contains(item)
+					}
+				}
+			})
+
+			b.Run("SmallSet", func(b *testing.B) {
+				s := New(items...)
+				for b.Loop() {
+					for _, item := range search {
+						s.Contains(item)
+					}
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkIter(b *testing.B) {
+	for _, size := range benchSizes {
+		items := benchItems(size)
+
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			b.Run("Set", func(b *testing.B) {
+				s := set.New(items...)
+				for b.Loop() {
+					for range s.Iter() { //nolint:revive // intentional empty block
+					}
+				}
+			})
+
+			b.Run("SmallSet", func(b *testing.B) {
+				s := New(items...)
+				for b.Loop() {
+					for range s.Iter() { //nolint:revive // intentional empty block
+					}
+				}
+			})
+		})
+	}
+}
+
+func benchItems(n int) []string {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = fmt.Sprintf("item-%d", i)
+	}
+	return items
+}
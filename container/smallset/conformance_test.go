@@ -0,0 +1,20 @@
+package smallset_test
+
+import (
+	"testing"
+
+	"go.prashantv.com/container/set/settest"
+	"go.prashantv.com/container/smallset"
+)
+
+func TestConformance(t *testing.T) {
+	settest.Run(t, smallset.New[int], identity)
+}
+
+func FuzzConformance(f *testing.F) {
+	settest.Fuzz(f, smallset.New[int], identity)
+}
+
+func identity(i int) int {
+	return i
+}
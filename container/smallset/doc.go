@@ -0,0 +1,7 @@
+// Package smallset implements a set optimized for a small number of items.
+//
+// Items are stored inline in a slice and found using a linear search,
+// which is faster and uses less memory than a map for small sets.
+// Once the set grows past a threshold, it switches to a [set.Set].
+// See benchmarks for more details.
+package smallset
@@ -0,0 +1,211 @@
+package smallset
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+
+	"go.prashantv.com/container/set"
+)
+
+// Threshold is the number of items stored inline before switching to a [set.Set].
+const Threshold = 16
+
+// Set implements set operations using a slice for small sets, and a [set.Set] for larger sets.
+// Once a set has switched to a [set.Set], it does not switch back, even if items are deleted.
+//
+// Methods match [set.Set], so it can be used as a replacement.
+// It is not safe for concurrent use.
+//
+// The zero value is an empty set ready to use.
+type Set[T comparable] struct {
+	small []T
+	large set.Set[T] // non-nil once the set grows past Threshold.
+}
+
+// New creates a set with items.
+func New[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{}
+	if len(items) <= Threshold {
+		s.small = make([]T, 0, len(items))
+	}
+	for _, item := range items {
+		s.Insert(item)
+	}
+	return s
+}
+
+// Len returns the number of items in the set.
+func (s *Set[T]) Len() int {
+	if s.large != nil {
+		return len(s.large)
+	}
+	return len(s.small)
+}
+
+// Contains returns if the set contains the specified item.
+func (s *Set[T]) Contains(item T) bool {
+	if s.large != nil {
+		return s.large.Contains(item)
+	}
+	return slices.Contains(s.small, item)
+}
+
+// ContainsAll returns if all the items exist in the set.
+func (s *Set[T]) ContainsAll(items []T) bool {
+	for _, item := range items {
+		if !s.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny returns true if any of the items exist in the set.
+// If no items are specified, it returns true, matching [set.Set].
+func (s *Set[T]) ContainsAny(items []T) bool {
+	if len(items) == 0 {
+		return true
+	}
+
+	for _, item := range items {
+		if s.Contains(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Copy returns a new set with the same items.
+func (s *Set[T]) Copy() *Set[T] {
+	if s.large != nil {
+		return &Set[T]{large: s.large.Copy()}
+	}
+	return &Set[T]{small: slices.Clone(s.small)}
+}
+
+// Insert inserts the item into the set, overwriting any existing items.
+func (s *Set[T]) Insert(item T) {
+	s.InsertUnique(item)
+}
+
+// InsertUnique inserts the item into the set if the item is not already in the set.
+// It returns true if the item did not previously exist, and was inserted.
+func (s *Set[T]) InsertUnique(item T) bool {
+	if s.large != nil {
+		return s.large.InsertUnique(item)
+	}
+
+	if slices.Contains(s.small, item) {
+		return false
+	}
+
+	if len(s.small) < Threshold {
+		s.small = append(s.small, item)
+		return true
+	}
+
+	s.large = set.New(s.small...)
+	s.large.Insert(item)
+	s.small = nil
+	return true
+}
+
+// InsertSeq inserts all values from seq into the set, overwriting any existing items.
+func (s *Set[T]) InsertSeq(seq iter.Seq[T]) {
+	for item := range seq {
+		s.Insert(item)
+	}
+}
+
+// Intersect returns a set that only contains items that are in both sets.
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	intersect := &Set[T]{}
+	for item := range s.Iter() {
+		if other.Contains(item) {
+			intersect.Insert(item)
+		}
+	}
+	return intersect
+}
+
+// Delete deletes the item from the set.
+func (s *Set[T]) Delete(item T) {
+	s.DeleteExists(item)
+}
+
+// DeleteExists deletes the item from the set if it exists.
+// It returns true if the item was deleted.
+func (s *Set[T]) DeleteExists(item T) bool {
+	if s.large != nil {
+		return s.large.DeleteExists(item)
+	}
+
+	i := slices.Index(s.small, item)
+	if i < 0 {
+		return false
+	}
+
+	// Order does not matter, so swap with the last element to avoid shifting.
+	last := len(s.small) - 1
+	s.small[i] = s.small[last]
+	var zero T
+	s.small[last] = zero
+	s.small = s.small[:last]
+	return true
+}
+
+// Equals returns if the two sets are equal.
+func (s *Set[T]) Equals(other *Set[T]) bool {
+	if s.Len() != other.Len() {
+		return false
+	}
+	return s.SubsetOf(other)
+}
+
+// SubsetOf returns if other contains all elements in s.
+func (s *Set[T]) SubsetOf(other *Set[T]) bool {
+	for item := range s.Iter() {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// SupersetOf returns if s contains all elements in other.
+func (s *Set[T]) SupersetOf(other *Set[T]) bool {
+	return other.SubsetOf(s)
+}
+
+// Unordered returns an unordered set of values in the set.
+//
+// Use `Ordered` when deterministic output is required.
+func (s *Set[T]) Unordered() []T {
+	if s.large != nil {
+		return s.large.Unordered()
+	}
+	return append(make([]T, 0, len(s.small)), s.small...)
+}
+
+// Union returns a set with elements from both sets.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	union := s.Copy()
+	union.InsertSeq(other.Iter())
+	return union
+}
+
+// Iter returns an iterator over all items in the set.
+func (s *Set[T]) Iter() iter.Seq[T] {
+	if s.large != nil {
+		return s.large.Iter()
+	}
+	return slices.Values(s.small)
+}
+
+// Ordered returns an ordered set of values in the set.
+func Ordered[T cmp.Ordered](s *Set[T]) []T {
+	vs := s.Unordered()
+	slices.Sort(vs)
+	return vs
+}
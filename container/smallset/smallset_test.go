@@ -0,0 +1,132 @@
+package smallset
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSet_ZeroValue(t *testing.T) {
+	var s Set[string]
+	assertEq(t, 0, s.Len())
+	assertEq(t, false, s.Contains("a"))
+	assertEq(t, []string{}, s.Unordered())
+
+	assertEq(t, true, s.InsertUnique("a"))
+	assertEq(t, true, s.Contains("a"))
+}
+
+func TestSet_Threshold(t *testing.T) {
+	s := New[int]()
+	for i := range Threshold {
+		s.Insert(i)
+		assertEq(t, true, s.large == nil)
+	}
+
+	assertEq(t, false, s.InsertUnique(0))
+	assertEq(t, true, s.large == nil)
+
+	s.Insert(Threshold)
+	assertEq(t, false, s.large == nil)
+	assertEq(t, Threshold+1, s.Len())
+	for i := range Threshold + 1 {
+		assertEq(t, true, s.Contains(i))
+	}
+
+	// Once upgraded, deletes do not downgrade.
+	for i := range Threshold {
+		assertEq(t, true, s.DeleteExists(i))
+	}
+	assertEq(t, false, s.large == nil)
+	assertEq(t, []int{Threshold}, s.Unordered())
+}
+
+func TestSet_Ops(t *testing.T) {
+	// Run the same operations on small and upgraded sets.
+	tests := []struct {
+		name  string
+		extra int // extra items added to force an upgrade.
+	}{
+		{name: "small", extra: 0},
+		{name: "large", extra: Threshold * 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var extra []int
+			for i := range tt.extra {
+				extra = append(extra, 1000+i)
+			}
+
+			a := New(append([]int{1, 2, 3}, extra...)...)
+			b := New(3, 4)
+
+			assertEq(t, 3+tt.extra, a.Len())
+			assertEq(t, true, a.ContainsAll([]int{1, 2}))
+			assertEq(t, false, a.ContainsAll([]int{1, 4}))
+			assertEq(t, true, a.ContainsAny([]int{4, 3}))
+			assertEq(t, false, a.ContainsAny([]int{4, 5}))
+			assertEq(t, true, a.ContainsAny(nil))
+
+			assertEq(t, []int{3}, Ordered(a.Intersect(b)))
+			assertEq(t, append([]int{1, 2, 3, 4}, extra...), Ordered(a.Union(b)))
+
+			c := a.Copy()
+			assertEq(t, true, c.Equals(a))
+			c.Delete(1)
+			assertEq(t, false, c.Equals(a))
+			assertEq(t, true, c.SubsetOf(a))
+			assertEq(t, true, a.SupersetOf(c))
+			assertEq(t, false, a.SubsetOf(c))
+			assertEq(t, true, a.Contains(1))
+
+			assertEq(t, true, a.DeleteExists(2))
+			assertEq(t, false, a.DeleteExists(2))
+
+			a.InsertSeq(slices.Values([]int{5, 6}))
+			assertEq(t, append([]int{1, 3, 5, 6}, extra...), slices.Sorted(a.Iter()))
+		})
+	}
+}
+
+func TestSet_DeleteOrder(t *testing.T) {
+	s := New("a", "b", "c", "d")
+	s.Delete("b")
+	s.Delete("a")
+	assertEq(t, []string{"c", "d"}, Ordered(s))
+
+	s.Delete("missing")
+	s.Delete("d")
+	s.Delete("c")
+	assertEq(t, 0, s.Len())
+	assertEq(t, []string{}, Ordered(s))
+}
+
+func TestSet_Iter_Break(t *testing.T) {
+	s := New("a", "b", "c")
+
+	var got []string
+	for item := range s.Iter() {
+		got = append(got, item)
+		if len(got) == 2 {
+			break
+		}
+	}
+
+	assertEq(t, 2, len(got))
+	assertEq(t, true, s.ContainsAll(got))
+}
+
+func assertEq(t testing.TB, want any, got any) {
+	t.Helper()
+
+	if reflect.DeepEqual(want, got) {
+		return
+	}
+
+	t.Fatalf(`assertEq failed, got:
+%+v
+-- want --
+%+v
+`, got, want)
+}
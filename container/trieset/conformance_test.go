@@ -0,0 +1,17 @@
+package trieset_test
+
+import (
+	"strconv"
+	"testing"
+
+	"go.prashantv.com/container/set/settest"
+	"go.prashantv.com/container/trieset"
+)
+
+func TestConformance(t *testing.T) {
+	settest.Run(t, trieset.New, strconv.Itoa)
+}
+
+func FuzzConformance(f *testing.F) {
+	settest.Fuzz(f, trieset.New, strconv.Itoa)
+}
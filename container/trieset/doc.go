@@ -0,0 +1,3 @@
+// Package trieset implements a set of strings using a radix tree,
+// supporting efficient prefix queries.
+package trieset
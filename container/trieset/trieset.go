@@ -0,0 +1,396 @@
+package trieset
+
+import (
+	"iter"
+	"slices"
+	"strings"
+)
+
+// Set implements set operations for strings using a radix tree.
+// In addition to the methods of set.Set, it supports prefix queries.
+//
+// Iteration yields items in lexicographic byte order.
+// It is not safe for concurrent use.
+//
+// The zero value is an empty set ready to use.
+type Set struct {
+	root node
+	len  int
+}
+
+// node is a radix tree node. All non-root nodes are either terminal,
+// or have at least 2 children, so that the tree stays compact.
+type node struct {
+	// label is the edge label from the parent to this node.
+	label    string
+	terminal bool
+
+	// children are sorted by the first byte of their label, which is unique among siblings.
+	children []*node
+}
+
+// New creates a set with items.
+func New(items ...string) *Set {
+	s := &Set{}
+	for _, item := range items {
+		s.Insert(item)
+	}
+	return s
+}
+
+// Len returns the number of items in the set.
+func (s *Set) Len() int {
+	return s.len
+}
+
+// Contains returns if the set contains the specified item.
+func (s *Set) Contains(item string) bool {
+	n := &s.root
+	for len(item) > 0 {
+		c, _ := n.child(item[0])
+		if c == nil || !strings.HasPrefix(item, c.label) {
+			return false
+		}
+		item = item[len(c.label):]
+		n = c
+	}
+	return n.terminal
+}
+
+// ContainsAll returns if all the items exist in the set.
+func (s *Set) ContainsAll(items []string) bool {
+	for _, item := range items {
+		if !s.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny returns true if any of the items exist in the set.
+// If no items are specified, it returns true, matching set.Set.
+func (s *Set) ContainsAny(items []string) bool {
+	if len(items) == 0 {
+		return true
+	}
+
+	for _, item := range items {
+		if s.Contains(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Copy returns a new set with the same items.
+func (s *Set) Copy() *Set {
+	return &Set{
+		root: *s.root.copy(),
+		len:  s.len,
+	}
+}
+
+// Insert inserts the item into the set, overwriting any existing items.
+func (s *Set) Insert(item string) {
+	s.InsertUnique(item)
+}
+
+// InsertUnique inserts the item into the set if the item is not already in the set.
+// It returns true if the item did not previously exist, and was inserted.
+func (s *Set) InsertUnique(item string) bool {
+	n := &s.root
+	for len(item) > 0 {
+		c, i := n.child(item[0])
+		if c == nil {
+			n.children = slices.Insert(n.children, i, &node{label: item, terminal: true})
+			s.len++
+			return true
+		}
+
+		common := commonPrefixLen(item, c.label)
+		if common < len(c.label) {
+			// Split the child, so the common prefix is a separate node.
+			split := &node{
+				label:    c.label[:common],
+				children: []*node{c},
+			}
+			c.label = c.label[common:]
+			n.children[i] = split
+			c = split
+		}
+
+		item = item[common:]
+		n = c
+	}
+
+	if n.terminal {
+		return false
+	}
+
+	n.terminal = true
+	s.len++
+	return true
+}
+
+// InsertSeq inserts all values from seq into the set, overwriting any existing items.
+func (s *Set) InsertSeq(seq iter.Seq[string]) {
+	for item := range seq {
+		s.Insert(item)
+	}
+}
+
+// Intersect returns a set that only contains items that are in both sets.
+func (s *Set) Intersect(other *Set) *Set {
+	intersect := &Set{}
+	for item := range s.Iter() {
+		if other.Contains(item) {
+			intersect.Insert(item)
+		}
+	}
+	return intersect
+}
+
+// Delete deletes the item from the set.
+func (s *Set) Delete(item string) {
+	s.DeleteExists(item)
+}
+
+// DeleteExists deletes the item from the set if it exists.
+// It returns true if the item was deleted.
+//
+// Nodes that are no longer needed are removed or merged, so the tree stays compact.
+func (s *Set) DeleteExists(item string) bool {
+	// Track the parent for compaction after deletion.
+	var parent *node
+	n := &s.root
+	for len(item) > 0 {
+		c, _ := n.child(item[0])
+		if c == nil || !strings.HasPrefix(item, c.label) {
+			return false
+		}
+		item = item[len(c.label):]
+		parent, n = n, c
+	}
+
+	if !n.terminal {
+		return false
+	}
+
+	n.terminal = false
+	s.len--
+
+	if parent == nil {
+		// The root is never removed or merged.
+		return true
+	}
+
+	switch len(n.children) {
+	case 0:
+		parent.removeChild(n)
+		// The parent may now have a single child, and need merging.
+		if parent != &s.root && !parent.terminal && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case 1:
+		n.mergeChild()
+	}
+	return true
+}
+
+// Equals returns if the two sets are equal.
+func (s *Set) Equals(other *Set) bool {
+	if s.len != other.len {
+		return false
+	}
+	return s.SubsetOf(other)
+}
+
+// SubsetOf returns if other contains all elements in s.
+func (s *Set) SubsetOf(other *Set) bool {
+	for item := range s.Iter() {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// SupersetOf returns if s contains all elements in other.
+func (s *Set) SupersetOf(other *Set) bool {
+	return other.SubsetOf(s)
+}
+
+// Unordered returns the values in the set.
+// Unlike set.Set, the values are always in lexicographic order.
+func (s *Set) Unordered() []string {
+	items := make([]string, 0, s.len)
+	for item := range s.Iter() {
+		items = append(items, item)
+	}
+	return items
+}
+
+// Union returns a set with elements from both sets.
+func (s *Set) Union(other *Set) *Set {
+	union := s.Copy()
+	union.InsertSeq(other.Iter())
+	return union
+}
+
+// Iter returns an iterator over all items in the set, in lexicographic order.
+func (s *Set) Iter() iter.Seq[string] {
+	return s.WithPrefix("")
+}
+
+// HasPrefix returns if any item in the set starts with prefix.
+func (s *Set) HasPrefix(prefix string) bool {
+	n, _ := s.findPrefix(prefix)
+	// All non-root nodes have a terminal node in their subtree.
+	return n != nil && (n != &s.root || s.len > 0)
+}
+
+// WithPrefix returns an iterator over all items in the set that start with prefix,
+// in lexicographic order.
+func (s *Set) WithPrefix(prefix string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		n, path := s.findPrefix(prefix)
+		if n == nil {
+			return
+		}
+
+		buf := []byte(path)
+		n.walk(buf, yield)
+	}
+}
+
+// LongestPrefixOf returns the longest item in the set that is a prefix of str.
+// It returns false if no item is a prefix of str.
+func (s *Set) LongestPrefixOf(str string) (string, bool) {
+	var (
+		longest int
+		found   bool
+	)
+
+	n := &s.root
+	consumed := 0
+	for {
+		if n.terminal {
+			longest, found = consumed, true
+		}
+
+		remaining := str[consumed:]
+		if len(remaining) == 0 {
+			break
+		}
+
+		c, _ := n.child(remaining[0])
+		if c == nil || !strings.HasPrefix(remaining, c.label) {
+			break
+		}
+		consumed += len(c.label)
+		n = c
+	}
+
+	if !found {
+		return "", false
+	}
+	return str[:longest], true
+}
+
+// findPrefix finds the highest node whose path starts with prefix.
+// It returns the node and its full path, or nil if no node matches.
+func (s *Set) findPrefix(prefix string) (*node, string) {
+	n := &s.root
+	consumed := 0
+	for consumed < len(prefix) {
+		remaining := prefix[consumed:]
+		c, _ := n.child(remaining[0])
+		if c == nil {
+			return nil, ""
+		}
+
+		if len(remaining) <= len(c.label) {
+			// The prefix ends within (or at the end of) this label.
+			if !strings.HasPrefix(c.label, remaining) {
+				return nil, ""
+			}
+			return c, prefix[:consumed] + c.label
+		}
+
+		if !strings.HasPrefix(remaining, c.label) {
+			return nil, ""
+		}
+		consumed += len(c.label)
+		n = c
+	}
+	return n, prefix
+}
+
+// Ordered returns an ordered set of values in the set.
+func Ordered(s *Set) []string {
+	return s.Unordered()
+}
+
+// child returns the child starting with b, and its index.
+// If there's no such child, it returns nil and the index to insert it at.
+func (n *node) child(b byte) (*node, int) {
+	i, ok := slices.BinarySearchFunc(n.children, b, func(c *node, b byte) int {
+		return int(c.label[0]) - int(b)
+	})
+	if !ok {
+		return nil, i
+	}
+	return n.children[i], i
+}
+
+func (n *node) removeChild(c *node) {
+	_, i := n.child(c.label[0])
+	n.children = slices.Delete(n.children, i, i+1)
+}
+
+// mergeChild merges a non-terminal node with its only child.
+func (n *node) mergeChild() {
+	c := n.children[0]
+	n.label += c.label
+	n.terminal = c.terminal
+	n.children = c.children
+}
+
+func (n *node) copy() *node {
+	clone := &node{
+		label:    n.label,
+		terminal: n.terminal,
+	}
+	if len(n.children) > 0 {
+		clone.children = make([]*node, len(n.children))
+		for i, c := range n.children {
+			clone.children[i] = c.copy()
+		}
+	}
+	return clone
+}
+
+// walk yields all terminal paths in the subtree in lexicographic order.
+// path is the full path to n.
+func (n *node) walk(path []byte, yield func(string) bool) bool {
+	if n.terminal && !yield(string(path)) {
+		return false
+	}
+
+	for _, c := range n.children {
+		if !c.walk(append(path, c.label...), yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func commonPrefixLen(a, b string) int {
+	n := min(len(a), len(b))
+	for i := range n {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return n
+}
@@ -0,0 +1,291 @@
+package trieset
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSet_Contains(t *testing.T) {
+	s := New("romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", "rom")
+	assertEq(t, 8, s.Len())
+	checkInvariants(t, s)
+
+	for _, item := range []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", "rom"} {
+		assertEq(t, true, s.Contains(item))
+	}
+	for _, item := range []string{"", "r", "ro", "roma", "roman", "rubicons", "x"} {
+		assertEq(t, false, s.Contains(item))
+	}
+}
+
+func TestSet_EmptyString(t *testing.T) {
+	var s Set
+	assertEq(t, false, s.Contains(""))
+	assertEq(t, false, s.HasPrefix(""))
+
+	assertEq(t, true, s.InsertUnique(""))
+	assertEq(t, false, s.InsertUnique(""))
+	assertEq(t, true, s.Contains(""))
+	assertEq(t, true, s.HasPrefix(""))
+	assertEq(t, []string{""}, s.Unordered())
+
+	longest, ok := s.LongestPrefixOf("abc")
+	assertEq(t, true, ok)
+	assertEq(t, "", longest)
+
+	assertEq(t, true, s.DeleteExists(""))
+	assertEq(t, false, s.DeleteExists(""))
+	assertEq(t, 0, s.Len())
+}
+
+func TestSet_Iter(t *testing.T) {
+	items := []string{"b", "abc", "a", "ab", "abd", "c", "ba"}
+	s := New(items...)
+
+	want := slices.Clone(items)
+	slices.Sort(want)
+	assertEq(t, want, slices.Collect(s.Iter()))
+	assertEq(t, want, s.Unordered())
+	assertEq(t, want, Ordered(s))
+
+	t.Run("break", func(t *testing.T) {
+		var got []string
+		for item := range s.Iter() {
+			got = append(got, item)
+			if len(got) == 2 {
+				break
+			}
+		}
+		assertEq(t, []string{"a", "ab"}, got)
+	})
+}
+
+func TestSet_Prefix(t *testing.T) {
+	s := New("feature.a", "feature.b.x", "feature.b.y", "features", "flag", "/api/v1/users", "/api/v1/", "/api/")
+
+	tests := []struct {
+		prefix        string
+		wantHasPrefix bool
+		wantWith      []string
+	}{
+		{
+			prefix:        "",
+			wantHasPrefix: true,
+			wantWith:      Ordered(s),
+		},
+		{
+			prefix:        "f",
+			wantHasPrefix: true,
+			wantWith:      []string{"feature.a", "feature.b.x", "feature.b.y", "features", "flag"},
+		},
+		{
+			prefix:        "feat",
+			wantHasPrefix: true,
+			wantWith:      []string{"feature.a", "feature.b.x", "feature.b.y", "features"},
+		},
+		{
+			prefix:        "feature.",
+			wantHasPrefix: true,
+			wantWith:      []string{"feature.a", "feature.b.x", "feature.b.y"},
+		},
+		{
+			prefix:        "feature.b",
+			wantHasPrefix: true,
+			wantWith:      []string{"feature.b.x", "feature.b.y"},
+		},
+		{
+			prefix:        "feature.b.x",
+			wantHasPrefix: true,
+			wantWith:      []string{"feature.b.x"},
+		},
+		{
+			prefix:        "feature.b.xy",
+			wantHasPrefix: false,
+		},
+		{
+			prefix:        "featz",
+			wantHasPrefix: false,
+		},
+		{
+			prefix:        "/api/v1",
+			wantHasPrefix: true,
+			wantWith:      []string{"/api/v1/", "/api/v1/users"},
+		},
+		{
+			prefix:        "x",
+			wantHasPrefix: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			assertEq(t, tt.wantHasPrefix, s.HasPrefix(tt.prefix))
+			assertEq(t, tt.wantWith, slices.Collect(s.WithPrefix(tt.prefix)))
+		})
+	}
+}
+
+func TestSet_LongestPrefixOf(t *testing.T) {
+	s := New("/", "/api/", "/api/v1/", "/static")
+
+	tests := []struct {
+		in     string
+		want   string
+		wantOK bool
+	}{
+		{in: "", wantOK: false},
+		{in: "api", wantOK: false},
+		{in: "/", want: "/", wantOK: true},
+		{in: "/api", want: "/", wantOK: true},
+		{in: "/api/", want: "/api/", wantOK: true},
+		{in: "/api/v1/users", want: "/api/v1/", wantOK: true},
+		{in: "/api/v2/users", want: "/api/", wantOK: true},
+		{in: "/static/main.js", want: "/static", wantOK: true},
+		{in: "/stat", want: "/", wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, ok := s.LongestPrefixOf(tt.in)
+			assertEq(t, tt.wantOK, ok)
+			assertEq(t, tt.want, got)
+		})
+	}
+}
+
+func TestSet_DeleteCompacts(t *testing.T) {
+	s := New("test", "team", "tea", "toast")
+	checkInvariants(t, s)
+
+	assertEq(t, true, s.DeleteExists("tea"))
+	checkInvariants(t, s)
+	assertEq(t, false, s.DeleteExists("tea"))
+	assertEq(t, false, s.DeleteExists("te"))
+
+	s.Delete("team")
+	checkInvariants(t, s)
+	assertEq(t, []string{"test", "toast"}, Ordered(s))
+
+	s.Delete("toast")
+	checkInvariants(t, s)
+	assertEq(t, 1, len(s.root.children))
+	assertEq(t, "test", s.root.children[0].label)
+
+	s.Delete("test")
+	checkInvariants(t, s)
+	assertEq(t, 0, len(s.root.children))
+	assertEq(t, 0, s.Len())
+}
+
+func TestSet_SetOps(t *testing.T) {
+	a := New("a", "ab", "abc")
+	b := New("ab", "b")
+
+	assertEq(t, true, a.ContainsAll([]string{"a", "abc"}))
+	assertEq(t, false, a.ContainsAll([]string{"a", "b"}))
+	assertEq(t, true, a.ContainsAny([]string{"b", "ab"}))
+	assertEq(t, false, a.ContainsAny([]string{"b", "abcd"}))
+	assertEq(t, true, a.ContainsAny(nil))
+
+	assertEq(t, []string{"ab"}, Ordered(a.Intersect(b)))
+	assertEq(t, []string{"a", "ab", "abc", "b"}, Ordered(a.Union(b)))
+
+	c := a.Copy()
+	assertEq(t, true, c.Equals(a))
+	c.Delete("abc")
+	checkInvariants(t, c)
+	checkInvariants(t, a)
+	assertEq(t, true, a.Contains("abc"))
+	assertEq(t, false, c.Equals(a))
+	assertEq(t, true, c.SubsetOf(a))
+	assertEq(t, true, a.SupersetOf(c))
+	assertEq(t, false, c.SupersetOf(a))
+
+	c.InsertSeq(slices.Values([]string{"x", "y"}))
+	assertEq(t, []string{"a", "ab", "x", "y"}, Ordered(c))
+}
+
+func TestSet_Random(t *testing.T) {
+	const ops = 10000
+
+	alphabet := []byte("abc")
+	randString := func() string {
+		b := make([]byte, rand.Intn(6))
+		for i := range b {
+			b[i] = alphabet[rand.Intn(len(alphabet))]
+		}
+		return string(b)
+	}
+
+	var s Set
+	model := make(map[string]struct{})
+	for range ops {
+		item := randString()
+		_, exists := model[item]
+		if rand.Intn(2) == 0 {
+			assertEq(t, !exists, s.InsertUnique(item))
+			model[item] = struct{}{}
+		} else {
+			assertEq(t, exists, s.DeleteExists(item))
+			delete(model, item)
+		}
+
+		assertEq(t, len(model), s.Len())
+		checkInvariants(t, &s)
+	}
+
+	want := make([]string, 0, len(model))
+	for item := range model {
+		want = append(want, item)
+	}
+	slices.Sort(want)
+	assertEq(t, want, Ordered(&s))
+}
+
+// checkInvariants verifies the radix tree is compact and consistent.
+func checkInvariants(t testing.TB, s *Set) {
+	t.Helper()
+
+	var count int
+	var check func(n *node, isRoot bool)
+	check = func(n *node, isRoot bool) {
+		if n.terminal {
+			count++
+		}
+		if !isRoot {
+			if n.label == "" {
+				t.Fatalf("non-root node with empty label")
+			}
+			if !n.terminal && len(n.children) < 2 {
+				t.Fatalf("non-terminal node %q has %v children", n.label, len(n.children))
+			}
+		}
+		for i, c := range n.children {
+			if i > 0 && n.children[i-1].label[0] >= c.label[0] {
+				t.Fatalf("children of %q are not sorted: %q >= %q", n.label, n.children[i-1].label, c.label)
+			}
+			check(c, false)
+		}
+	}
+	check(&s.root, true)
+
+	if count != s.Len() {
+		t.Fatalf("found %v terminal nodes, Len is %v", count, s.Len())
+	}
+}
+
+func assertEq(t testing.TB, want any, got any) {
+	t.Helper()
+
+	if reflect.DeepEqual(want, got) {
+		return
+	}
+
+	t.Fatalf(`assertEq failed, got:
+%+v
+-- want --
+%+v
+`, got, want)
+}
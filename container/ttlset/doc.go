@@ -0,0 +1,4 @@
+// Package ttlset implements a set where items expire after a time-to-live.
+//
+// Expired items are purged lazily as the set is used, without any background goroutines.
+package ttlset
@@ -0,0 +1,3 @@
+module go.prashantv.com/container/ttlset
+
+go 1.24
@@ -0,0 +1,215 @@
+package ttlset
+
+import (
+	"container/heap"
+	"iter"
+	"time"
+)
+
+// Clock is the source of the current time used to expire items.
+type Clock interface {
+	Now() time.Time
+}
+
+// Set is a set where each item expires after a time-to-live (TTL).
+// Expired items are never visible, and are purged lazily:
+// lookups remove the expired item, and inserts purge all expired items.
+//
+// A Set must be created using [New]. It is not safe for concurrent use.
+type Set[T comparable] struct {
+	// Clock is used to get the current time. If nil, the system clock is used.
+	Clock Clock
+
+	ttl   time.Duration
+	items map[T]*entry[T]
+
+	// expiries orders entries by expiry, so expired entries can be purged
+	// without scanning all items.
+	expiries expiryHeap[T]
+}
+
+type entry[T comparable] struct {
+	item      T
+	expiresAt time.Time
+	index     int // index in expiries.
+}
+
+// New creates an empty set where items expire after ttl by default.
+func New[T comparable](ttl time.Duration) *Set[T] {
+	return &Set[T]{
+		ttl:   ttl,
+		items: make(map[T]*entry[T]),
+	}
+}
+
+// TTL returns the default TTL used by Insert.
+func (s *Set[T]) TTL() time.Duration {
+	return s.ttl
+}
+
+// Len returns the number of unexpired items in the set.
+func (s *Set[T]) Len() int {
+	s.purge(s.now())
+	return len(s.items)
+}
+
+// Contains returns if the set contains the specified unexpired item.
+func (s *Set[T]) Contains(item T) bool {
+	e, ok := s.items[item]
+	if !ok {
+		return false
+	}
+
+	if s.expired(e, s.now()) {
+		s.remove(e)
+		return false
+	}
+	return true
+}
+
+// ExpiresAt returns the time the item expires, and whether the item is in the set.
+func (s *Set[T]) ExpiresAt(item T) (time.Time, bool) {
+	if !s.Contains(item) {
+		return time.Time{}, false
+	}
+	return s.items[item].expiresAt, true
+}
+
+// Insert inserts the item into the set using the default TTL.
+// If the item already exists, its expiry is reset.
+func (s *Set[T]) Insert(item T) {
+	s.InsertTTL(item, s.ttl)
+}
+
+// InsertTTL inserts the item into the set, expiring after ttl.
+// If the item already exists, its expiry is reset.
+func (s *Set[T]) InsertTTL(item T, ttl time.Duration) {
+	now := s.now()
+	s.purge(now)
+
+	expiresAt := now.Add(ttl)
+	if e, ok := s.items[item]; ok {
+		e.expiresAt = expiresAt
+		heap.Fix(&s.expiries, e.index)
+		return
+	}
+
+	e := &entry[T]{
+		item:      item,
+		expiresAt: expiresAt,
+	}
+	s.items[item] = e
+	heap.Push(&s.expiries, e)
+}
+
+// InsertUnique inserts the item into the set using the default TTL,
+// if the item is not already in the set. The expiry of an existing item is not modified.
+// It returns true if the item did not previously exist, and was inserted.
+func (s *Set[T]) InsertUnique(item T) bool {
+	if s.Contains(item) {
+		return false
+	}
+
+	s.Insert(item)
+	return true
+}
+
+// Delete deletes the item from the set.
+func (s *Set[T]) Delete(item T) {
+	s.DeleteExists(item)
+}
+
+// DeleteExists deletes the item from the set if it exists and is unexpired.
+// It returns true if the item was deleted.
+func (s *Set[T]) DeleteExists(item T) bool {
+	if !s.Contains(item) {
+		return false
+	}
+
+	s.remove(s.items[item])
+	return true
+}
+
+// Expire removes all items that are expired at now.
+// It returns the number of removed items.
+//
+// Expired items are never visible, so Expire is only needed to release memory
+// for sets that are not modified frequently.
+func (s *Set[T]) Expire(now time.Time) int {
+	return s.purge(now)
+}
+
+// Iter returns an iterator over all unexpired items in the set.
+// Since it relies on Go map iteration order, the order of the items is non-deterministic.
+func (s *Set[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		now := s.now()
+		for item, e := range s.items {
+			if s.expired(e, now) {
+				continue
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+func (s *Set[T]) now() time.Time {
+	if s.Clock == nil {
+		return time.Now()
+	}
+	return s.Clock.Now()
+}
+
+func (s *Set[T]) expired(e *entry[T], now time.Time) bool {
+	return !now.Before(e.expiresAt)
+}
+
+// purge removes all expired items. Since every item is purged at most once,
+// the cost of purging is amortized across inserts.
+func (s *Set[T]) purge(now time.Time) int {
+	var purged int
+	for len(s.expiries) > 0 && s.expired(s.expiries[0], now) {
+		s.remove(s.expiries[0])
+		purged++
+	}
+	return purged
+}
+
+func (s *Set[T]) remove(e *entry[T]) {
+	heap.Remove(&s.expiries, e.index)
+	delete(s.items, e.item)
+}
+
+// expiryHeap implements heap.Interface as a min-heap ordered by expiry.
+type expiryHeap[T comparable] []*entry[T]
+
+func (h expiryHeap[T]) Len() int {
+	return len(h)
+}
+
+func (h expiryHeap[T]) Less(i, j int) bool {
+	return h[i].expiresAt.Before(h[j].expiresAt)
+}
+
+func (h expiryHeap[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *expiryHeap[T]) Push(x any) {
+	e := x.(*entry[T])
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *expiryHeap[T]) Pop() any {
+	old := *h
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return e
+}
@@ -0,0 +1,179 @@
+package ttlset
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestSet(ttl time.Duration) (*Set[string], *fakeClock) {
+	clock := newFakeClock()
+	s := New[string](ttl)
+	s.Clock = clock
+	return s, clock
+}
+
+func TestSet_Expiry(t *testing.T) {
+	s, clock := newTestSet(time.Minute)
+	assertEq(t, time.Minute, s.TTL())
+
+	s.Insert("a")
+	assertEq(t, true, s.Contains("a"))
+	assertEq(t, 1, s.Len())
+
+	clock.Advance(time.Minute - time.Nanosecond)
+	assertEq(t, true, s.Contains("a"))
+
+	clock.Advance(time.Nanosecond)
+	assertEq(t, false, s.Contains("a"))
+	assertEq(t, 0, s.Len())
+}
+
+func TestSet_InsertTTL(t *testing.T) {
+	s, clock := newTestSet(time.Minute)
+	s.Insert("default")
+	s.InsertTTL("short", time.Second)
+	s.InsertTTL("long", time.Hour)
+
+	expiresAt, ok := s.ExpiresAt("short")
+	assertEq(t, true, ok)
+	assertEq(t, clock.Now().Add(time.Second), expiresAt)
+
+	clock.Advance(time.Second)
+	assertEq(t, []string{"default", "long"}, slices.Sorted(s.Iter()))
+
+	clock.Advance(time.Minute)
+	assertEq(t, []string{"long"}, slices.Sorted(s.Iter()))
+
+	clock.Advance(time.Hour)
+	assertEq(t, 0, s.Len())
+
+	_, ok = s.ExpiresAt("long")
+	assertEq(t, false, ok)
+}
+
+func TestSet_InsertResetsExpiry(t *testing.T) {
+	s, clock := newTestSet(time.Minute)
+	s.Insert("a")
+
+	clock.Advance(30 * time.Second)
+	s.Insert("a")
+
+	clock.Advance(45 * time.Second)
+	assertEq(t, true, s.Contains("a"))
+
+	clock.Advance(15 * time.Second)
+	assertEq(t, false, s.Contains("a"))
+}
+
+func TestSet_InsertUnique(t *testing.T) {
+	s, clock := newTestSet(time.Minute)
+	assertEq(t, true, s.InsertUnique("a"))
+
+	// InsertUnique does not extend the expiry of existing items.
+	clock.Advance(30 * time.Second)
+	assertEq(t, false, s.InsertUnique("a"))
+
+	clock.Advance(30 * time.Second)
+	assertEq(t, false, s.Contains("a"))
+	assertEq(t, true, s.InsertUnique("a"))
+	assertEq(t, true, s.Contains("a"))
+}
+
+func TestSet_Delete(t *testing.T) {
+	s, clock := newTestSet(time.Minute)
+	s.Insert("a")
+	s.Insert("b")
+	s.InsertTTL("c", time.Second)
+
+	assertEq(t, true, s.DeleteExists("a"))
+	assertEq(t, false, s.DeleteExists("a"))
+
+	s.Delete("b")
+	assertEq(t, false, s.Contains("b"))
+
+	clock.Advance(time.Second)
+	assertEq(t, false, s.DeleteExists("c"))
+	assertEq(t, 0, s.Len())
+}
+
+func TestSet_Expire(t *testing.T) {
+	s, clock := newTestSet(time.Minute)
+	for i, item := range []string{"a", "b", "c", "d"} {
+		s.InsertTTL(item, time.Duration(i+1)*time.Second)
+	}
+
+	assertEq(t, 0, s.Expire(clock.Now()))
+	assertEq(t, 2, s.Expire(clock.Now().Add(2*time.Second)))
+	assertEq(t, 2, len(s.items))
+	assertEq(t, 2, s.Expire(clock.Now().Add(time.Hour)))
+	assertEq(t, 0, len(s.items))
+}
+
+func TestSet_PurgeOnInsert(t *testing.T) {
+	s, clock := newTestSet(time.Second)
+	for i := range 100 {
+		s.Insert(string(rune('a' + i%26)))
+		clock.Advance(time.Second)
+	}
+
+	// Every insert purges the previously expired items, so memory is bounded.
+	assertEq(t, 1, len(s.items))
+	assertEq(t, 1, len(s.expiries))
+}
+
+func TestSet_Iter_Break(t *testing.T) {
+	s, _ := newTestSet(time.Minute)
+	s.Insert("a")
+	s.Insert("b")
+	s.Insert("c")
+
+	var got []string
+	for item := range s.Iter() {
+		got = append(got, item)
+		if len(got) == 2 {
+			break
+		}
+	}
+	assertEq(t, 2, len(got))
+}
+
+func TestSet_SystemClock(t *testing.T) {
+	s := New[string](time.Hour)
+	s.Insert("a")
+	assertEq(t, true, s.Contains("a"))
+
+	s.InsertTTL("b", -time.Second)
+	assertEq(t, false, s.Contains("b"))
+}
+
+func assertEq(t testing.TB, want any, got any) {
+	t.Helper()
+
+	if reflect.DeepEqual(want, got) {
+		return
+	}
+
+	t.Fatalf(`assertEq failed, got:
+%+v
+-- want --
+%+v
+`, got, want)
+}
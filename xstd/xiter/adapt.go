@@ -0,0 +1,47 @@
+package xiter
+
+import "iter"
+
+// Keys returns a sequence of the keys in seq.
+func Keys[K, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence of the values in seq.
+func Values[K, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ToSeq2 returns a sequence of pairs created by calling fn on each value in seq.
+func ToSeq2[T, K, V any](seq iter.Seq[T], fn func(T) (K, V)) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for v := range seq {
+			if !yield(fn(v)) {
+				return
+			}
+		}
+	}
+}
+
+// ToSeq returns a sequence of values created by calling fn on each pair in seq.
+func ToSeq[K, V, T any](seq iter.Seq2[K, V], fn func(K, V) T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for k, v := range seq {
+			if !yield(fn(k, v)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package xiter
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestKeysValues(t *testing.T) {
+	seq := Enumerate(slices.Values([]string{"a", "b", "c"}))
+	assertEq(t, []int{0, 1, 2}, slices.Collect(Keys(seq)))
+	assertEq(t, []string{"a", "b", "c"}, slices.Collect(Values(seq)))
+
+	checkStops(t, func(seq iter.Seq[int]) iter.Seq[int] {
+		return Keys(Enumerate(seq))
+	})
+	checkStops(t, func(seq iter.Seq[int]) iter.Seq[int] {
+		return Values(Enumerate(seq))
+	})
+}
+
+func TestToSeq2(t *testing.T) {
+	seq := ToSeq2(slices.Values([]int{1, 2}), func(v int) (string, int) {
+		return strconv.Itoa(v), v * v
+	})
+	assertEq(t, map[string]int{"1": 1, "2": 4}, maps.Collect(seq))
+
+	checkStops2(t, func(seq iter.Seq[int]) iter.Seq2[int, int] {
+		return ToSeq2(seq, func(v int) (int, int) { return v, v })
+	})
+}
+
+func TestToSeq(t *testing.T) {
+	seq := ToSeq(Enumerate(slices.Values([]string{"a", "b"})), func(i int, v string) string {
+		return strconv.Itoa(i) + v
+	})
+	assertEq(t, []string{"0a", "1b"}, slices.Collect(seq))
+
+	checkStops(t, func(seq iter.Seq[int]) iter.Seq[int] {
+		return ToSeq(Enumerate(seq), func(i, v int) int { return i + v })
+	})
+}
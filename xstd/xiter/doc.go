@@ -0,0 +1,5 @@
+// Package xiter contains lazy combinators that extend the functionality of [iter].
+//
+// Combinators do no work until the returned sequence is iterated,
+// and stop iterating their inputs as soon as the consumer stops.
+package xiter
@@ -0,0 +1,3 @@
+module go.prashantv.com/xstd/xiter
+
+go 1.24
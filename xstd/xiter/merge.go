@@ -0,0 +1,50 @@
+package xiter
+
+import (
+	"cmp"
+	"iter"
+)
+
+// Merge returns a sorted sequence of the values in a and b, which must both be sorted.
+// Equal values are all retained, with values from a before values from b.
+func Merge[T cmp.Ordered](a, b iter.Seq[T]) iter.Seq[T] {
+	return MergeFunc(a, b, cmp.Compare[T])
+}
+
+// MergeFunc returns a sorted sequence of the values in a and b, which must both be sorted by cmp.
+// Equal values are all retained, with values from a before values from b.
+func MergeFunc[T any](a, b iter.Seq[T], cmp func(T, T) int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		nextA, stopA := iter.Pull(a)
+		defer stopA()
+		nextB, stopB := iter.Pull(b)
+		defer stopB()
+
+		va, okA := nextA()
+		vb, okB := nextB()
+		for okA && okB {
+			if cmp(va, vb) <= 0 {
+				if !yield(va) {
+					return
+				}
+				va, okA = nextA()
+			} else {
+				if !yield(vb) {
+					return
+				}
+				vb, okB = nextB()
+			}
+		}
+
+		for ; okA; va, okA = nextA() {
+			if !yield(va) {
+				return
+			}
+		}
+		for ; okB; vb, okB = nextB() {
+			if !yield(vb) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package xiter
+
+import (
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []int
+		b    []int
+		want []int
+	}{
+		{
+			name: "both empty",
+			want: nil,
+		},
+		{
+			name: "a empty",
+			b:    []int{1, 2},
+			want: []int{1, 2},
+		},
+		{
+			name: "b empty",
+			a:    []int{1, 2},
+			want: []int{1, 2},
+		},
+		{
+			name: "interleaved",
+			a:    []int{1, 3, 5, 7},
+			b:    []int{2, 4},
+			want: []int{1, 2, 3, 4, 5, 7},
+		},
+		{
+			name: "duplicates retained",
+			a:    []int{1, 2, 2},
+			b:    []int{2, 3},
+			want: []int{1, 2, 2, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertEq(t, tt.want, slices.Collect(Merge(slices.Values(tt.a), slices.Values(tt.b))))
+		})
+	}
+
+	checkStops(t, func(seq iter.Seq[int]) iter.Seq[int] {
+		return Merge(seq, slices.Values([]int{1, 2, 3}))
+	})
+	checkStops(t, func(seq iter.Seq[int]) iter.Seq[int] {
+		return Merge(slices.Values([]int{20, 30}), seq)
+	})
+	checkStops(t, func(seq iter.Seq[int]) iter.Seq[int] {
+		return Merge(empty[int](), seq)
+	})
+}
+
+func TestMergeFunc(t *testing.T) {
+	a := slices.Values([]string{"a", "B", "c"})
+	b := slices.Values([]string{"A", "b"})
+	got := slices.Collect(MergeFunc(a, b, func(x, y string) int {
+		return strings.Compare(strings.ToLower(x), strings.ToLower(y))
+	}))
+
+	// Equal values from a are before equal values from b.
+	assertEq(t, []string{"a", "A", "B", "b", "c"}, got)
+}
@@ -0,0 +1,165 @@
+package xiter
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Map returns a sequence of fn applied to each value in seq.
+func Map[X, Y any](seq iter.Seq[X], fn func(X) Y) iter.Seq[Y] {
+	return func(yield func(Y) bool) {
+		for x := range seq {
+			if !yield(fn(x)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a sequence of the values in seq for which fn returns true.
+func Filter[T any](seq iter.Seq[T], fn func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if fn(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Take returns a sequence of the first n values in seq.
+// seq is not iterated past the n-th value.
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+
+		var taken int
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			taken++
+			if taken >= n {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns a sequence of the values in seq after skipping the first n values.
+func Skip[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var skipped int
+		for v := range seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile returns a sequence of the values in seq until fn returns false.
+// seq is not iterated past the first value for which fn returns false.
+func TakeWhile[T any](seq iter.Seq[T], fn func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if !fn(v) || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Concat returns a sequence of the values in each of seqs, in order.
+func Concat[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, seq := range seqs {
+			for v := range seq {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Enumerate returns a sequence of the values in seq, paired with their index.
+func Enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var i int
+		for v := range seq {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Chunk returns a sequence of consecutive slices of up to size values from seq.
+// All chunks except the last have exactly size values.
+// Each chunk is a new slice, so it's safe to retain.
+//
+// Chunk panics if size is less than 1.
+func Chunk[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size < 1 {
+		panic(fmt.Sprintf("xiter: invalid chunk size %d", size))
+	}
+
+	return func(yield func([]T) bool) {
+		var chunk []T
+		for v := range seq {
+			if chunk == nil {
+				chunk = make([]T, 0, size)
+			}
+			chunk = append(chunk, v)
+			if len(chunk) < size {
+				continue
+			}
+
+			if !yield(chunk) {
+				return
+			}
+			chunk = nil
+		}
+
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Dedup returns a sequence of the values in seq, skipping values that have already been seen.
+// All previously seen values are retained for the duration of the iteration.
+func Dedup[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+		for v := range seq {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Reduce combines the values in seq into a single value,
+// by calling fn with the accumulated value and each value in order, starting with init.
+// Unlike other functions in this package, Reduce consumes seq immediately.
+func Reduce[T, A any](seq iter.Seq[T], init A, fn func(A, T) A) A {
+	acc := init
+	for v := range seq {
+		acc = fn(acc, v)
+	}
+	return acc
+}
@@ -0,0 +1,329 @@
+package xiter
+
+import (
+	"iter"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	got := slices.Collect(Map(slices.Values([]int{1, 2, 3}), strconv.Itoa))
+	assertEq(t, []string{"1", "2", "3"}, got)
+
+	assertEq(t, []string(nil), slices.Collect(Map(empty[int](), strconv.Itoa)))
+
+	checkStops(t, func(seq iter.Seq[int]) iter.Seq[string] {
+		return Map(seq, strconv.Itoa)
+	})
+}
+
+func TestFilter(t *testing.T) {
+	got := slices.Collect(Filter(slices.Values([]int{1, 2, 3, 4, 5}), isOdd))
+	assertEq(t, []int{1, 3, 5}, got)
+
+	checkStops(t, func(seq iter.Seq[int]) iter.Seq[int] {
+		return Filter(seq, isOdd)
+	})
+}
+
+func TestTake(t *testing.T) {
+	tests := []struct {
+		name       string
+		n          int
+		want       []int
+		wantPulled int
+	}{
+		{
+			name:       "negative",
+			n:          -1,
+			want:       nil,
+			wantPulled: 0,
+		},
+		{
+			name:       "zero",
+			n:          0,
+			want:       nil,
+			wantPulled: 0,
+		},
+		{
+			name:       "fewer than seq",
+			n:          2,
+			want:       []int{1, 2},
+			wantPulled: 2,
+		},
+		{
+			name:       "more than seq",
+			n:          10,
+			want:       []int{1, 2, 3},
+			wantPulled: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := newSource(1, 2, 3)
+			assertEq(t, tt.want, slices.Collect(Take(src.Seq(), tt.n)))
+			assertEq(t, tt.wantPulled, src.pulled)
+		})
+	}
+
+	checkStops(t, func(seq iter.Seq[int]) iter.Seq[int] {
+		return Take(seq, 10)
+	})
+}
+
+func TestSkip(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{
+			name: "negative",
+			n:    -1,
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "fewer than seq",
+			n:    2,
+			want: []int{3},
+		},
+		{
+			name: "more than seq",
+			n:    10,
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertEq(t, tt.want, slices.Collect(Skip(slices.Values([]int{1, 2, 3}), tt.n)))
+		})
+	}
+
+	checkStops(t, func(seq iter.Seq[int]) iter.Seq[int] {
+		return Skip(seq, 1)
+	})
+}
+
+func TestTakeWhile(t *testing.T) {
+	src := newSource(1, 3, 4, 5)
+	assertEq(t, []int{1, 3}, slices.Collect(TakeWhile(src.Seq(), isOdd)))
+	assertEq(t, 3, src.pulled)
+
+	checkStops(t, func(seq iter.Seq[int]) iter.Seq[int] {
+		return TakeWhile(seq, func(int) bool { return true })
+	})
+}
+
+func TestConcat(t *testing.T) {
+	assertEq(t, []int(nil), slices.Collect(Concat[int]()))
+
+	got := slices.Collect(Concat(slices.Values([]int{1, 2}), empty[int](), slices.Values([]int{3})))
+	assertEq(t, []int{1, 2, 3}, got)
+
+	t.Run("stops", func(t *testing.T) {
+		first, second := newSource(1, 2), newSource(3, 4)
+		seq := Concat(first.Seq(), second.Seq())
+		for v := range seq {
+			if v == 3 {
+				break
+			}
+		}
+		assertEq(t, 2, first.pulled)
+		assertEq(t, 1, second.pulled)
+		assertEq(t, true, second.done)
+	})
+
+	checkStops(t, func(seq iter.Seq[int]) iter.Seq[int] {
+		return Concat(seq, seq)
+	})
+}
+
+func TestEnumerate(t *testing.T) {
+	var (
+		gotIdx []int
+		gotV   []string
+	)
+	for i, v := range Enumerate(slices.Values([]string{"a", "b", "c"})) {
+		gotIdx = append(gotIdx, i)
+		gotV = append(gotV, v)
+	}
+	assertEq(t, []int{0, 1, 2}, gotIdx)
+	assertEq(t, []string{"a", "b", "c"}, gotV)
+
+	checkStops2(t, func(seq iter.Seq[int]) iter.Seq2[int, int] {
+		return Enumerate(seq)
+	})
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		size int
+		want [][]int
+	}{
+		{
+			name: "empty",
+			in:   nil,
+			size: 2,
+			want: nil,
+		},
+		{
+			name: "exact",
+			in:   []int{1, 2, 3, 4},
+			size: 2,
+			want: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name: "remainder",
+			in:   []int{1, 2, 3, 4, 5},
+			size: 2,
+			want: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name: "size larger than seq",
+			in:   []int{1, 2},
+			size: 5,
+			want: [][]int{{1, 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertEq(t, tt.want, slices.Collect(Chunk(slices.Values(tt.in), tt.size)))
+		})
+	}
+
+	t.Run("chunks are not reused", func(t *testing.T) {
+		var chunks [][]int
+		for chunk := range Chunk(slices.Values([]int{1, 2, 3, 4}), 2) {
+			chunks = append(chunks, chunk)
+		}
+		chunks[0] = append(chunks[0], 100)
+		assertEq(t, []int{3, 4}, chunks[1])
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected panic")
+			}
+		}()
+		Chunk(empty[int](), 0)
+	})
+
+	checkStops(t, func(seq iter.Seq[int]) iter.Seq[[]int] {
+		return Chunk(seq, 1)
+	})
+	checkStops(t, func(seq iter.Seq[int]) iter.Seq[[]int] {
+		// The final partial chunk is yielded after seq is exhausted.
+		return Chunk(Take(seq, 1), 2)
+	})
+}
+
+func TestDedup(t *testing.T) {
+	got := slices.Collect(Dedup(slices.Values([]int{1, 2, 1, 3, 2, 4})))
+	assertEq(t, []int{1, 2, 3, 4}, got)
+
+	checkStops(t, Dedup[int])
+}
+
+func TestReduce(t *testing.T) {
+	sum := func(acc, v int) int { return acc + v }
+	assertEq(t, 100, Reduce(empty[int](), 100, sum))
+	assertEq(t, 106, Reduce(slices.Values([]int{1, 2, 3}), 100, sum))
+
+	joined := Reduce(slices.Values([]int{1, 2, 3}), "", func(acc string, v int) string {
+		return acc + strconv.Itoa(v)
+	})
+	assertEq(t, "123", joined)
+}
+
+func isOdd(v int) bool {
+	return v%2 == 1
+}
+
+func empty[T any]() iter.Seq[T] {
+	return func(func(T) bool) {}
+}
+
+// source is a sequence that tracks how it's consumed.
+type source[T any] struct {
+	values []T
+	pulled int
+	done   bool
+}
+
+func newSource[T any](values ...T) *source[T] {
+	return &source[T]{values: values}
+}
+
+func (s *source[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		defer func() { s.done = true }()
+
+		for _, v := range s.values {
+			s.pulled++
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// checkStops verifies that the combinator stops on the first yield returning false:
+// it does not call yield again (which panics when used with range),
+// does not pull more values than needed, and returns control from seq.
+func checkStops[T any](t *testing.T, combinator func(iter.Seq[int]) iter.Seq[T]) {
+	t.Helper()
+
+	checkStopsPull(t, func(seq iter.Seq[int]) int {
+		var n int
+		for range combinator(seq) {
+			n++
+			break
+		}
+		return n
+	})
+}
+
+// checkStops2 is the equivalent of checkStops for combinators that return an [iter.Seq2].
+func checkStops2[K, V any](t *testing.T, combinator func(iter.Seq[int]) iter.Seq2[K, V]) {
+	t.Helper()
+
+	checkStopsPull(t, func(seq iter.Seq[int]) int {
+		var n int
+		for range combinator(seq) {
+			n++
+			break
+		}
+		return n
+	})
+}
+
+func checkStopsPull(t *testing.T, consume func(iter.Seq[int]) int) {
+	t.Helper()
+
+	src := newSource(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	if n := consume(src.Seq()); n != 1 {
+		t.Fatalf("expected 1 value before break, got %v", n)
+	}
+	if src.pulled >= len(src.values) {
+		t.Fatalf("expected iteration to stop early, pulled all %v values", src.pulled)
+	}
+	if !src.done {
+		t.Fatalf("source sequence did not return after break")
+	}
+}
+
+func assertEq(t testing.TB, want, got any) {
+	t.Helper()
+
+	if !reflect.DeepEqual(want, got) {
+		t.Fatalf("assertEq failed, got:\n%+v\n-- want --\n%+v\n", got, want)
+	}
+}
@@ -0,0 +1,19 @@
+package xiter
+
+import "iter"
+
+// Zip returns a sequence of pairs of values from as and bs, in order.
+// The sequence stops when either as or bs is exhausted.
+func Zip[A, B any](as iter.Seq[A], bs iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		nextB, stop := iter.Pull(bs)
+		defer stop()
+
+		for a := range as {
+			b, ok := nextB()
+			if !ok || !yield(a, b) {
+				return
+			}
+		}
+	}
+}
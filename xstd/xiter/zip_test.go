@@ -0,0 +1,73 @@
+package xiter
+
+import (
+	"iter"
+	"slices"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		name  string
+		as    []int
+		bs    []string
+		wantA []int
+		wantB []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:  "same length",
+			as:    []int{1, 2},
+			bs:    []string{"a", "b"},
+			wantA: []int{1, 2},
+			wantB: []string{"a", "b"},
+		},
+		{
+			name:  "as shorter",
+			as:    []int{1},
+			bs:    []string{"a", "b"},
+			wantA: []int{1},
+			wantB: []string{"a"},
+		},
+		{
+			name:  "bs shorter",
+			as:    []int{1, 2, 3},
+			bs:    []string{"a"},
+			wantA: []int{1},
+			wantB: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				gotA []int
+				gotB []string
+			)
+			for a, b := range Zip(slices.Values(tt.as), slices.Values(tt.bs)) {
+				gotA = append(gotA, a)
+				gotB = append(gotB, b)
+			}
+			assertEq(t, tt.wantA, gotA)
+			assertEq(t, tt.wantB, gotB)
+		})
+	}
+
+	t.Run("stops pulled sequence", func(t *testing.T) {
+		as, bs := newSource(1, 2, 3), newSource(4, 5, 6)
+		for range Zip(as.Seq(), bs.Seq()) {
+			break
+		}
+		assertEq(t, true, as.done)
+		assertEq(t, true, bs.done)
+	})
+
+	checkStops2(t, func(seq iter.Seq[int]) iter.Seq2[int, int] {
+		return Zip(seq, slices.Values([]int{1, 2, 3}))
+	})
+	checkStops2(t, func(seq iter.Seq[int]) iter.Seq2[int, int] {
+		return Zip(slices.Values([]int{1, 2, 3}), seq)
+	})
+}
@@ -0,0 +1,58 @@
+package xmaps
+
+// Change is a value that differs between two maps.
+type Change[V any] struct {
+	Old V
+	New V
+}
+
+// Delta is the difference between two maps, as returned by [Diff].
+type Delta[K comparable, V any] struct {
+	// Added contains entries in the new map with keys not in the old map.
+	Added map[K]V
+
+	// Removed contains entries in the old map with keys not in the new map.
+	Removed map[K]V
+
+	// Changed contains the old and new values for keys in both maps with different values.
+	Changed map[K]Change[V]
+}
+
+// Empty returns if there are no differences.
+func (d Delta[K, V]) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Diff returns the entries added, removed and changed from oldM to newM.
+func Diff[K, V comparable](oldM, newM map[K]V) Delta[K, V] {
+	return DiffFunc(oldM, newM, func(a, b V) bool {
+		return a == b
+	})
+}
+
+// DiffFunc returns the entries added, removed and changed from oldM to newM,
+// using eq to compare values.
+func DiffFunc[K comparable, V any](oldM, newM map[K]V, eq func(V, V) bool) Delta[K, V] {
+	d := Delta[K, V]{
+		Added:   make(map[K]V),
+		Removed: make(map[K]V),
+		Changed: make(map[K]Change[V]),
+	}
+
+	for k, oldV := range oldM {
+		newV, ok := newM[k]
+		if !ok {
+			d.Removed[k] = oldV
+			continue
+		}
+		if !eq(oldV, newV) {
+			d.Changed[k] = Change[V]{Old: oldV, New: newV}
+		}
+	}
+	for k, newV := range newM {
+		if _, ok := oldM[k]; !ok {
+			d.Added[k] = newV
+		}
+	}
+	return d
+}
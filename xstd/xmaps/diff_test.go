@@ -0,0 +1,67 @@
+package xmaps
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name      string
+		oldM      map[string]int
+		newM      map[string]int
+		want      Delta[string, int]
+		wantEmpty bool
+	}{
+		{
+			name: "both nil",
+			want: Delta[string, int]{
+				Added:   map[string]int{},
+				Removed: map[string]int{},
+				Changed: map[string]Change[int]{},
+			},
+			wantEmpty: true,
+		},
+		{
+			name: "equal",
+			oldM: map[string]int{"a": 1},
+			newM: map[string]int{"a": 1},
+			want: Delta[string, int]{
+				Added:   map[string]int{},
+				Removed: map[string]int{},
+				Changed: map[string]Change[int]{},
+			},
+			wantEmpty: true,
+		},
+		{
+			name: "added, removed and changed",
+			oldM: map[string]int{"same": 1, "removed": 2, "changed": 3},
+			newM: map[string]int{"same": 1, "added": 4, "changed": 5},
+			want: Delta[string, int]{
+				Added:   map[string]int{"added": 4},
+				Removed: map[string]int{"removed": 2},
+				Changed: map[string]Change[int]{"changed": {Old: 3, New: 5}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Diff(tt.oldM, tt.newM)
+			assertEq(t, tt.want, got)
+			assertEq(t, tt.wantEmpty, got.Empty())
+		})
+	}
+}
+
+func TestDiffFunc(t *testing.T) {
+	oldM := map[string][]int{"a": {1, 2}, "b": {3}}
+	newM := map[string][]int{"a": {1, 2}, "b": {3, 4}}
+
+	got := DiffFunc(oldM, newM, slices.Equal[[]int])
+	assertEq(t, Delta[string, []int]{
+		Added:   map[string][]int{},
+		Removed: map[string][]int{},
+		Changed: map[string]Change[[]int]{"b": {Old: []int{3}, New: []int{3, 4}}},
+	}, got)
+}
@@ -0,0 +1,2 @@
+// Package xmaps contains functions that extend the functionality of [maps].
+package xmaps
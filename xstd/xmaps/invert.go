@@ -0,0 +1,38 @@
+package xmaps
+
+import "go.prashantv.com/container/set"
+
+// Invert returns a map from each value to its key.
+// If multiple keys have the same value, an error wrapping [ErrCollision] is returned.
+func Invert[K, V comparable](m map[K]V) (map[V]K, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	inverted := make(map[V]K, len(m))
+	for k, v := range m {
+		if existing, ok := inverted[v]; ok {
+			return nil, collisionError(existing, k, v)
+		}
+		inverted[v] = k
+	}
+	return inverted, nil
+}
+
+// InvertSet returns a map from each value to the set of keys with that value.
+func InvertSet[K, V comparable](m map[K]V) map[V]set.Set[K] {
+	if m == nil {
+		return nil
+	}
+
+	inverted := make(map[V]set.Set[K])
+	for k, v := range m {
+		keys, ok := inverted[v]
+		if !ok {
+			keys = set.New[K]()
+			inverted[v] = keys
+		}
+		keys.Insert(k)
+	}
+	return inverted
+}
@@ -0,0 +1,54 @@
+package xmaps
+
+import (
+	"errors"
+	"testing"
+
+	"go.prashantv.com/container/set"
+)
+
+func TestInvert(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      map[string]int
+		want    map[int]string
+		wantErr string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "unique values",
+			in:   map[string]int{"a": 1, "b": 2},
+			want: map[int]string{1: "a", 2: "b"},
+		},
+		{
+			name:    "duplicate values",
+			in:      map[string]int{"a": 1, "b": 1},
+			wantErr: "both map to 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Invert(tt.in)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+			if tt.wantErr != "" {
+				assertEq(t, true, errors.Is(err, ErrCollision))
+			}
+		})
+	}
+}
+
+func TestInvertSet(t *testing.T) {
+	assertEq(t, map[int]set.Set[string](nil), InvertSet[string, int](nil))
+
+	got := InvertSet(map[string]int{"a": 1, "b": 2, "c": 1})
+	assertEq(t, map[int]set.Set[string]{
+		1: set.New("a", "c"),
+		2: set.New("b"),
+	}, got)
+}
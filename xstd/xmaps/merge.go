@@ -0,0 +1,22 @@
+package xmaps
+
+// Merge returns a new map with the entries of a and b.
+// If a key exists in both maps, the value is fn(key, aValue, bValue).
+// If fn is nil, the value from b is used.
+func Merge[K comparable, V any](a, b map[K]V, fn func(K, V, V) V) map[K]V {
+	if a == nil && b == nil {
+		return nil
+	}
+
+	merged := make(map[K]V, max(len(a), len(b)))
+	for k, v := range a {
+		merged[k] = v
+	}
+	for k, v := range b {
+		if existing, ok := merged[k]; ok && fn != nil {
+			v = fn(k, existing, v)
+		}
+		merged[k] = v
+	}
+	return merged
+}
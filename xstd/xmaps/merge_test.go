@@ -0,0 +1,55 @@
+package xmaps
+
+import "testing"
+
+func TestMerge(t *testing.T) {
+	sum := func(_ string, a, b int) int { return a + b }
+
+	tests := []struct {
+		name string
+		a    map[string]int
+		b    map[string]int
+		fn   func(string, int, int) int
+		want map[string]int
+	}{
+		{
+			name: "both nil",
+			want: nil,
+		},
+		{
+			name: "a nil",
+			b:    map[string]int{"a": 1},
+			want: map[string]int{"a": 1},
+		},
+		{
+			name: "b nil",
+			a:    map[string]int{"a": 1},
+			want: map[string]int{"a": 1},
+		},
+		{
+			name: "conflict uses b",
+			a:    map[string]int{"a": 1, "b": 2},
+			b:    map[string]int{"b": 3, "c": 4},
+			want: map[string]int{"a": 1, "b": 3, "c": 4},
+		},
+		{
+			name: "conflict uses fn",
+			a:    map[string]int{"a": 1, "b": 2},
+			b:    map[string]int{"b": 3, "c": 4},
+			fn:   sum,
+			want: map[string]int{"a": 1, "b": 5, "c": 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertEq(t, tt.want, Merge(tt.a, tt.b, tt.fn))
+		})
+	}
+
+	t.Run("does not modify inputs", func(t *testing.T) {
+		a := map[string]int{"a": 1}
+		Merge(a, map[string]int{"a": 2, "b": 3}, sum)
+		assertEq(t, map[string]int{"a": 1}, a)
+	})
+}
@@ -0,0 +1,33 @@
+package xmaps
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// SortedKeys returns an iterator over the keys in m in sorted order.
+// Keys are collected and sorted when iteration starts.
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, k := range slices.Sorted(maps.Keys(m)) {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SortedAll returns an iterator over the entries in m in sorted key order.
+// Keys are collected and sorted when iteration starts,
+// and values are read from m as each entry is yielded.
+func SortedAll[K cmp.Ordered, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range slices.Sorted(maps.Keys(m)) {
+			if !yield(k, m[k]) {
+				return
+			}
+		}
+	}
+}
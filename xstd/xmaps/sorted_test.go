@@ -0,0 +1,38 @@
+package xmaps
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSortedKeys(t *testing.T) {
+	m := map[string]int{"c": 3, "a": 1, "b": 2}
+	assertEq(t, []string{"a", "b", "c"}, slices.Collect(SortedKeys(m)))
+	assertEq(t, []string(nil), slices.Collect(SortedKeys(map[string]int(nil))))
+
+	for k := range SortedKeys(m) {
+		assertEq(t, "a", k)
+		break
+	}
+}
+
+func TestSortedAll(t *testing.T) {
+	m := map[int]string{3: "c", 1: "a", 2: "b"}
+
+	var (
+		keys   []int
+		values []string
+	)
+	for k, v := range SortedAll(m) {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	assertEq(t, []int{1, 2, 3}, keys)
+	assertEq(t, []string{"a", "b", "c"}, values)
+
+	for k, v := range SortedAll(m) {
+		assertEq(t, 1, k)
+		assertEq(t, "a", v)
+		break
+	}
+}
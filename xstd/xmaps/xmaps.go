@@ -0,0 +1,173 @@
+package xmaps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// KeyError is the error returned when mapping the entry with Key fails.
+type KeyError[K comparable] struct {
+	Key K
+	Err error
+}
+
+func (e *KeyError[K]) Error() string {
+	return fmt.Sprintf("key %v: %v", e.Key, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *KeyError[K]) Unwrap() error {
+	return e.Err
+}
+
+// ErrCollision is returned when multiple entries map to the same key.
+var ErrCollision = errors.New("xmaps: key collision")
+
+func collisionError[K, L comparable](k1, k2 K, l L) error {
+	return fmt.Errorf("%w: keys %v and %v both map to %v", ErrCollision, k1, k2, l)
+}
+
+// MapValues runs the mapping function on each value to create a new map with the same keys.
+func MapValues[K comparable, V, W any](m map[K]V, fn func(V) W) map[K]W {
+	if m == nil {
+		return nil
+	}
+
+	mapped := make(map[K]W, len(m))
+	for k, v := range m {
+		mapped[k] = fn(v)
+	}
+	return mapped
+}
+
+// MapValuesErr runs the mapping function on each value to create a new map with the same keys.
+// The mapping function may return an error which stops mapping.
+// The error is returned as a [*KeyError] with the partially mapped map.
+// Since map iteration order is not specified, the entries in the partially mapped map are not deterministic.
+func MapValuesErr[K comparable, V, W any](m map[K]V, fn func(V) (W, error)) (map[K]W, error) {
+	return MapValuesCtx(context.Background(), m, func(_ context.Context, v V) (W, error) {
+		return fn(v)
+	})
+}
+
+// MapValuesCtx runs the context-aware mapping function on each value to create a new map with the same keys.
+// The mapping function may return an error which stops mapping.
+// The error is returned as a [*KeyError] with the partially mapped map.
+// Since map iteration order is not specified, the entries in the partially mapped map are not deterministic.
+// MapValuesCtx does not explicitly check for context errors, the mapping function is expected to respect and propagate context errors.
+func MapValuesCtx[K comparable, V, W any](ctx context.Context, m map[K]V, fn func(context.Context, V) (W, error)) (map[K]W, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	mapped := make(map[K]W, len(m))
+	for k, v := range m {
+		w, err := fn(ctx, v)
+		if err != nil {
+			return mapped, &KeyError[K]{Key: k, Err: err}
+		}
+		mapped[k] = w
+	}
+	return mapped, nil
+}
+
+// MapKeys runs the mapping function on each key to create a new map with the same values.
+// If multiple keys map to the same key, the values are combined using merge.
+// Since map iteration order is not specified, merge should be commutative.
+// If merge is nil, an error wrapping [ErrCollision] is returned on the first collision.
+func MapKeys[K, L comparable, V any](m map[K]V, fn func(K) L, merge func(V, V) V) (map[L]V, error) {
+	return MapKeysCtx(context.Background(), m, func(_ context.Context, k K) (L, error) {
+		return fn(k), nil
+	}, merge)
+}
+
+// MapKeysErr runs the mapping function on each key to create a new map with the same values.
+// Collisions are handled the same as [MapKeys].
+// The mapping function may return an error which stops mapping.
+// The error is returned as a [*KeyError] with the partially mapped map.
+// Since map iteration order is not specified, the entries in the partially mapped map are not deterministic.
+func MapKeysErr[K, L comparable, V any](m map[K]V, fn func(K) (L, error), merge func(V, V) V) (map[L]V, error) {
+	return MapKeysCtx(context.Background(), m, func(_ context.Context, k K) (L, error) {
+		return fn(k)
+	}, merge)
+}
+
+// MapKeysCtx runs the context-aware mapping function on each key to create a new map with the same values.
+// Collisions are handled the same as [MapKeys].
+// The mapping function may return an error which stops mapping.
+// The error is returned as a [*KeyError] with the partially mapped map.
+// Since map iteration order is not specified, the entries in the partially mapped map are not deterministic.
+// MapKeysCtx does not explicitly check for context errors, the mapping function is expected to respect and propagate context errors.
+func MapKeysCtx[K, L comparable, V any](ctx context.Context, m map[K]V, fn func(context.Context, K) (L, error), merge func(V, V) V) (map[L]V, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	mapped := make(map[L]V, len(m))
+	sources := make(map[L]K, len(m)) // used to report collisions.
+	for k, v := range m {
+		l, err := fn(ctx, k)
+		if err != nil {
+			return mapped, &KeyError[K]{Key: k, Err: err}
+		}
+
+		if existing, ok := mapped[l]; ok {
+			if merge == nil {
+				return mapped, collisionError(sources[l], k, l)
+			}
+			v = merge(existing, v)
+		}
+		mapped[l] = v
+		sources[l] = k
+	}
+	return mapped, nil
+}
+
+// Filter returns a new map with the entries for which fn returns true.
+func Filter[K comparable, V any](m map[K]V, fn func(K, V) bool) map[K]V {
+	if m == nil {
+		return nil
+	}
+
+	filtered := make(map[K]V)
+	for k, v := range m {
+		if fn(k, v) {
+			filtered[k] = v
+		}
+	}
+	return filtered
+}
+
+// FilterErr returns a new map with the entries for which fn returns true.
+// The filter function may return an error which stops filtering.
+// The error is returned as a [*KeyError] with the partially filtered map.
+// Since map iteration order is not specified, the entries in the partially filtered map are not deterministic.
+func FilterErr[K comparable, V any](m map[K]V, fn func(K, V) (bool, error)) (map[K]V, error) {
+	return FilterCtx(context.Background(), m, func(_ context.Context, k K, v V) (bool, error) {
+		return fn(k, v)
+	})
+}
+
+// FilterCtx returns a new map with the entries for which the context-aware fn returns true.
+// The filter function may return an error which stops filtering.
+// The error is returned as a [*KeyError] with the partially filtered map.
+// Since map iteration order is not specified, the entries in the partially filtered map are not deterministic.
+// FilterCtx does not explicitly check for context errors, the filter function is expected to respect and propagate context errors.
+func FilterCtx[K comparable, V any](ctx context.Context, m map[K]V, fn func(context.Context, K, V) (bool, error)) (map[K]V, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	filtered := make(map[K]V)
+	for k, v := range m {
+		keep, err := fn(ctx, k, v)
+		if err != nil {
+			return filtered, &KeyError[K]{Key: k, Err: err}
+		}
+		if keep {
+			filtered[k] = v
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,261 @@
+package xmaps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMapValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      map[string]string
+		want    map[string]int
+		wantErr string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "empty",
+			in:   map[string]string{},
+			want: map[string]int{},
+		},
+		{
+			name: "multiple entries",
+			in:   map[string]string{"a": "1", "b": "2"},
+			want: map[string]int{"a": 1, "b": 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MapValues(tt.in, func(s string) int {
+				n, _ := strconv.Atoi(s)
+				return n
+			})
+			assertEq(t, tt.want, got)
+
+			got, err := MapValuesErr(tt.in, strconv.Atoi)
+			assertErr(t, "", err)
+			assertEq(t, tt.want, got)
+
+			got, err = MapValuesCtx(context.Background(), tt.in, func(_ context.Context, s string) (int, error) {
+				return strconv.Atoi(s)
+			})
+			assertErr(t, "", err)
+			assertEq(t, tt.want, got)
+		})
+	}
+
+	t.Run("err", func(t *testing.T) {
+		in := map[string]string{"a": "1", "b": "err", "c": "3"}
+
+		got, err := MapValuesErr(in, strconv.Atoi)
+		assertKeyErr(t, "b", err)
+		assertPartial(t, map[string]int{"a": 1, "c": 3}, got)
+
+		got, err = MapValuesCtx(context.Background(), in, func(_ context.Context, s string) (int, error) {
+			return strconv.Atoi(s)
+		})
+		assertKeyErr(t, "b", err)
+		assertPartial(t, map[string]int{"a": 1, "c": 3}, got)
+	})
+}
+
+func TestMapKeys(t *testing.T) {
+	sum := func(a, b int) int { return a + b }
+
+	tests := []struct {
+		name    string
+		in      map[string]int
+		merge   func(int, int) int
+		want    map[string]int
+		wantErr string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "no collisions",
+			in:   map[string]int{"a": 1, "b": 2},
+			want: map[string]int{"A": 1, "B": 2},
+		},
+		{
+			name:  "collisions merged",
+			in:    map[string]int{"a": 1, "A": 2, "b": 3},
+			merge: sum,
+			want:  map[string]int{"A": 3, "B": 3},
+		},
+		{
+			name:    "collision without merge",
+			in:      map[string]int{"a": 1, "A": 2},
+			wantErr: "xmaps: key collision: keys",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(got map[string]int, err error) {
+				t.Helper()
+
+				assertErr(t, tt.wantErr, err)
+				if tt.wantErr != "" {
+					assertEq(t, true, errors.Is(err, ErrCollision))
+					return
+				}
+				assertEq(t, tt.want, got)
+			}
+
+			check(MapKeys(tt.in, strings.ToUpper, tt.merge))
+			check(MapKeysErr(tt.in, func(s string) (string, error) {
+				return strings.ToUpper(s), nil
+			}, tt.merge))
+			check(MapKeysCtx(context.Background(), tt.in, func(_ context.Context, s string) (string, error) {
+				return strings.ToUpper(s), nil
+			}, tt.merge))
+		})
+	}
+
+	t.Run("err", func(t *testing.T) {
+		in := map[string]int{"1": 1, "err": 2}
+
+		got, err := MapKeysErr(in, strconv.Atoi, nil)
+		assertKeyErr(t, "err", err)
+		assertPartial(t, map[int]int{1: 1}, got)
+
+		got, err = MapKeysCtx(context.Background(), in, func(_ context.Context, s string) (int, error) {
+			return strconv.Atoi(s)
+		}, nil)
+		assertKeyErr(t, "err", err)
+		assertPartial(t, map[int]int{1: 1}, got)
+	})
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name string
+		in   map[string]int
+		want map[string]int
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "none match",
+			in:   map[string]int{"a": 1},
+			want: map[string]int{},
+		},
+		{
+			name: "some match",
+			in:   map[string]int{"a": 1, "b": 2, "c": 3, "d": 4},
+			want: map[string]int{"b": 2, "d": 4},
+		},
+	}
+
+	isEven := func(_ string, v int) bool { return v%2 == 0 }
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertEq(t, tt.want, Filter(tt.in, isEven))
+
+			got, err := FilterErr(tt.in, func(k string, v int) (bool, error) {
+				return isEven(k, v), nil
+			})
+			assertErr(t, "", err)
+			assertEq(t, tt.want, got)
+
+			got, err = FilterCtx(context.Background(), tt.in, func(_ context.Context, k string, v int) (bool, error) {
+				return isEven(k, v), nil
+			})
+			assertErr(t, "", err)
+			assertEq(t, tt.want, got)
+		})
+	}
+
+	t.Run("err", func(t *testing.T) {
+		errNegative := errors.New("negative")
+		in := map[string]int{"a": 2, "b": -1}
+		fn := func(_ string, v int) (bool, error) {
+			if v < 0 {
+				return false, errNegative
+			}
+			return true, nil
+		}
+
+		got, err := FilterErr(in, fn)
+		assertKeyErr(t, "b", err)
+		assertEq(t, true, errors.Is(err, errNegative))
+		assertPartial(t, map[string]int{"a": 2}, got)
+
+		got, err = FilterCtx(context.Background(), in, func(_ context.Context, k string, v int) (bool, error) {
+			return fn(k, v)
+		})
+		assertKeyErr(t, "b", err)
+		assertPartial(t, map[string]int{"a": 2}, got)
+	})
+}
+
+// assertKeyErr asserts that err is a [*KeyError] for key.
+func assertKeyErr[K comparable](t testing.TB, key K, err error) {
+	t.Helper()
+
+	var keyErr *KeyError[K]
+	if !errors.As(err, &keyErr) {
+		t.Fatalf("expected *KeyError, got %v", err)
+	}
+	assertEq(t, key, keyErr.Key)
+	assertErr(t, fmt.Sprintf("key %v: ", key), err)
+}
+
+// assertPartial asserts that every entry in the partial map matches full.
+func assertPartial[K comparable, V any](t testing.TB, full, partial map[K]V) {
+	t.Helper()
+
+	for k, v := range partial {
+		want, ok := full[k]
+		if !ok || !reflect.DeepEqual(want, v) {
+			t.Fatalf("unexpected partial entry %v: %v", k, v)
+		}
+	}
+}
+
+func assertEq(t testing.TB, want, got any) {
+	t.Helper()
+
+	if !reflect.DeepEqual(want, got) {
+		t.Fatalf("assertEq failed, got:\n%+v\n-- want --\n%+v\n", got, want)
+	}
+}
+
+func assertErr(t testing.TB, wantErr string, err error) {
+	t.Helper()
+
+	if wantErr == "" {
+		if err != nil {
+			t.Fatalf("assertErr failed, want no error, got:\n%v", err)
+		}
+		return
+	}
+
+	if err == nil {
+		t.Fatalf("assertErr failed, wanted error, got nil. wante:\n%v", wantErr)
+	}
+
+	if !strings.Contains(err.Error(), wantErr) {
+		t.Fatalf(`assertErr failed, got unexpected error:
+%v
+-- want (contains) --
+%v
+`, err, wantErr)
+	}
+}
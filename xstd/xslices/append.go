@@ -0,0 +1,66 @@
+package xslices
+
+import (
+	"context"
+	"slices"
+)
+
+// AppendMap runs the mapping function on each element of xs, appending the results to dst.
+// It returns the extended slice, which reuses the capacity of dst if possible.
+func AppendMap[X, Y any](dst []Y, xs []X, fn func(X) Y) []Y {
+	dst = slices.Grow(dst, len(xs))
+	for i := range xs {
+		dst = append(dst, fn(xs[i]))
+	}
+	return dst
+}
+
+// AppendMapErr runs the mapping function on each element of xs, appending the results to dst.
+// It returns the extended slice, which reuses the capacity of dst if possible.
+// The mapping function may return an error which stops mapping.
+// The error is returned as an [*IndexError] with dst extended by the results for all elements before the failed index.
+func AppendMapErr[X, Y any](dst []Y, xs []X, fn func(X) (Y, error)) ([]Y, error) {
+	return AppendMapCtx(context.Background(), dst, xs, func(_ context.Context, x X) (Y, error) {
+		return fn(x)
+	})
+}
+
+// AppendMapCtx runs the context-aware mapping function on each element of xs, appending the results to dst.
+// It returns the extended slice, which reuses the capacity of dst if possible.
+// The mapping function may return an error which stops mapping.
+// The error is returned as an [*IndexError] with dst extended by the results for all elements before the failed index.
+// AppendMapCtx does not explicitly check for context errors, the mapping function is expected to respect and propagate context errors.
+func AppendMapCtx[X, Y any](ctx context.Context, dst []Y, xs []X, fn func(context.Context, X) (Y, error)) ([]Y, error) {
+	dst = slices.Grow(dst, len(xs))
+	for i := range xs {
+		y, err := fn(ctx, xs[i])
+		if err != nil {
+			return dst, &IndexError{Index: i, Err: err}
+		}
+		dst = append(dst, y)
+	}
+	return dst, nil
+}
+
+// MapInto runs the mapping function to map a slice into dst, overwriting any existing elements.
+// It returns the mapped slice, which reuses the capacity of dst if possible.
+func MapInto[X, Y any](dst []Y, xs []X, fn func(X) Y) []Y {
+	return AppendMap(dst[:0], xs, fn)
+}
+
+// MapIntoErr runs the mapping function to map a slice into dst, overwriting any existing elements.
+// It returns the mapped slice, which reuses the capacity of dst if possible.
+// The mapping function may return an error which stops mapping.
+// The error is returned as an [*IndexError] with the results for all elements before the failed index.
+func MapIntoErr[X, Y any](dst []Y, xs []X, fn func(X) (Y, error)) ([]Y, error) {
+	return AppendMapErr(dst[:0], xs, fn)
+}
+
+// MapIntoCtx runs the context-aware mapping function to map a slice into dst, overwriting any existing elements.
+// It returns the mapped slice, which reuses the capacity of dst if possible.
+// The mapping function may return an error which stops mapping.
+// The error is returned as an [*IndexError] with the results for all elements before the failed index.
+// MapIntoCtx does not explicitly check for context errors, the mapping function is expected to respect and propagate context errors.
+func MapIntoCtx[X, Y any](ctx context.Context, dst []Y, xs []X, fn func(context.Context, X) (Y, error)) ([]Y, error) {
+	return AppendMapCtx(ctx, dst[:0], xs, fn)
+}
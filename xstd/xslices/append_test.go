@@ -0,0 +1,205 @@
+package xslices
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"go.prashantv.com/xstd/xsync"
+)
+
+func TestAppendMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		dst     []int
+		in      []string
+		want    []int
+		wantErr string
+	}{
+		{
+			name: "nil dst and xs",
+			want: nil,
+		},
+		{
+			name: "nil xs",
+			dst:  []int{1},
+			want: []int{1},
+		},
+		{
+			name: "nil dst",
+			in:   []string{"1", "2"},
+			want: []int{1, 2},
+		},
+		{
+			name: "append to dst",
+			dst:  []int{1},
+			in:   []string{"2", "3"},
+			want: []int{1, 2, 3},
+		},
+		{
+			name:    "err",
+			dst:     []int{1},
+			in:      []string{"2", "err", "3"},
+			want:    []int{1, 2},
+			wantErr: "index 1: strconv.Atoi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == "" {
+				got := AppendMap(clone(tt.dst), tt.in, func(s string) int {
+					n, _ := strconv.Atoi(s)
+					return n
+				})
+				assertEq(t, tt.want, got)
+			}
+
+			got, err := AppendMapErr(clone(tt.dst), tt.in, strconv.Atoi)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+
+			got, err = AppendMapCtx(context.Background(), clone(tt.dst), tt.in, func(_ context.Context, s string) (int, error) {
+				return strconv.Atoi(s)
+			})
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+		})
+	}
+}
+
+func TestMapInto(t *testing.T) {
+	tests := []struct {
+		name    string
+		dst     []int
+		in      []string
+		want    []int
+		wantErr string
+	}{
+		{
+			name: "nil dst and xs",
+			want: nil,
+		},
+		{
+			name: "nil xs",
+			dst:  []int{1},
+			want: []int{},
+		},
+		{
+			name: "overwrites dst",
+			dst:  []int{7, 8, 9},
+			in:   []string{"1", "2"},
+			want: []int{1, 2},
+		},
+		{
+			name: "grows dst",
+			dst:  []int{7},
+			in:   []string{"1", "2", "3"},
+			want: []int{1, 2, 3},
+		},
+		{
+			name:    "err",
+			dst:     []int{7, 8, 9},
+			in:      []string{"1", "err", "3"},
+			want:    []int{1},
+			wantErr: "index 1: strconv.Atoi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == "" {
+				got := MapInto(clone(tt.dst), tt.in, func(s string) int {
+					n, _ := strconv.Atoi(s)
+					return n
+				})
+				assertEq(t, tt.want, got)
+			}
+
+			got, err := MapIntoErr(clone(tt.dst), tt.in, strconv.Atoi)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+
+			got, err = MapIntoCtx(context.Background(), clone(tt.dst), tt.in, func(_ context.Context, s string) (int, error) {
+				return strconv.Atoi(s)
+			})
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+		})
+	}
+
+	t.Run("reuses capacity", func(t *testing.T) {
+		dst := make([]int, 0, 10)
+		got := MapInto(dst, []int{1, 2, 3}, func(x int) int { return x * 2 })
+		assertEq(t, []int{2, 4, 6}, got)
+		if &dst[:1][0] != &got[0] {
+			t.Fatalf("MapInto did not reuse the capacity of dst")
+		}
+	})
+}
+
+func TestMapInto_Allocs(t *testing.T) {
+	xs := make([]int, 100)
+	dst := make([]int, 0, len(xs))
+	double := func(x int) int { return x * 2 }
+
+	allocs := testing.AllocsPerRun(100, func() {
+		dst = MapInto(dst, xs, double)
+	})
+	assertEq(t, 0.0, allocs)
+}
+
+// clone returns a copy of s, so test cases can't affect each other through shared capacity.
+func clone[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	return append([]T(nil), s...)
+}
+
+func BenchmarkMapInto(b *testing.B) {
+	xs := make([]int, 10000)
+	fn := func(x int) int {
+		return x
+	}
+
+	b.Run("Map", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			Map(xs, fn)
+		}
+	})
+
+	b.Run("MapInto pooled", func(b *testing.B) {
+		// The pool holds pointers so Put does not allocate to box the slice header.
+		pool := xsync.Pool[*[]int]{
+			New: func() *[]int {
+				s := make([]int, 0, len(xs))
+				return &s
+			},
+		}
+
+		b.ReportAllocs()
+		for b.Loop() {
+			dst := pool.Get()
+			*dst = MapInto(*dst, xs, fn)
+			pool.Put(dst)
+		}
+	})
+
+	b.Run("AppendMap pooled", func(b *testing.B) {
+		pool := xsync.Pool[*[]int]{
+			New: func() *[]int {
+				s := make([]int, 0, len(xs))
+				return &s
+			},
+		}
+
+		b.ReportAllocs()
+		for b.Loop() {
+			dst := pool.Get()
+			*dst = AppendMap((*dst)[:0], xs, fn)
+			pool.Put(dst)
+		}
+	})
+}
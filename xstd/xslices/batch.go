@@ -0,0 +1,121 @@
+package xslices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrBatchResults is returned (wrapped in a [*BatchError]) when a batch mapping function
+// returns a different number of results than the number of elements in the batch.
+var ErrBatchResults = errors.New("batch returned wrong number of results")
+
+// BatchError is the error returned when mapping the batch of elements xs[Start:End] fails.
+type BatchError struct {
+	Batch      int
+	Start, End int
+	Err        error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("batch %d [%d, %d): %v", e.Batch, e.Start, e.End, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+// As matches an [*IndexError] target, so errors.As works the same as for other Map functions.
+// The IndexError reports Start, the index in xs of the first element in the failed batch.
+func (e *BatchError) As(target any) bool {
+	indexErr, ok := target.(**IndexError)
+	if !ok {
+		return false
+	}
+	*indexErr = &IndexError{Index: e.Start, Err: e.Err}
+	return true
+}
+
+// MapBatched runs the context-aware mapping function on consecutive batches of up to batchSize elements
+// to map a slice to a new slice. fn must return exactly one result per element in the batch, in order.
+// It panics if batchSize is not positive.
+//
+// The mapping function may return an error which stops mapping.
+// The error is returned as a [*BatchError] with the partially mapped slice,
+// which contains the results for all batches before the failed batch.
+// MapBatched does not explicitly check for context errors, the mapping function is expected to respect and propagate context errors.
+func MapBatched[X, Y any](ctx context.Context, xs []X, batchSize int, fn func(context.Context, []X) ([]Y, error)) ([]Y, error) {
+	batches := Chunk(xs, batchSize)
+	if batches == nil {
+		return nil, nil
+	}
+
+	ys := make([]Y, 0, len(xs))
+	for b, batch := range batches {
+		batchYs, err := callBatch(ctx, b, batchSize, batch, fn)
+		if err != nil {
+			return ys, err
+		}
+		ys = append(ys, batchYs...)
+	}
+	return ys, nil
+}
+
+// MapBatchedParallel is similar to [MapBatched], but maps batches concurrently.
+// The results are in the same order as xs.
+//
+// The first error stops new batches from starting, cancels the context passed to in-flight batches,
+// and is returned as a [*BatchError] after in-flight batches complete.
+// Since batches are mapped out of order, no partial results are returned on error.
+// Panics are propagated the same as [MapParallel], and opts.Limiter limits the rate of batch calls.
+//
+// opts.Observer is notified once per batch, using the batch index rather than element indexes,
+// with any error returned as a [*BatchError]. For example, an [ETA] should be created with the number of batches.
+func MapBatchedParallel[X, Y any](ctx context.Context, xs []X, batchSize int, fn func(context.Context, []X) ([]Y, error), opts ParallelOptions) ([]Y, error) {
+	batches := Chunk(xs, batchSize)
+	if batches == nil {
+		return nil, nil
+	}
+
+	clock := clockOrSystem(opts.Clock)
+	ys := make([]Y, len(xs))
+	err := runParallel(ctx, len(batches), opts, func(ctx context.Context, b int) error {
+		if opts.Limiter != nil {
+			if err := opts.Limiter.Wait(ctx); err != nil {
+				return newBatchError(b, batchSize, len(batches[b]), err)
+			}
+		}
+
+		batchYs, err := observe(opts.Observer, clock, b, func() ([]Y, error) {
+			return callBatch(ctx, b, batchSize, batches[b], fn)
+		})
+		if err != nil {
+			return err
+		}
+		copy(ys[b*batchSize:], batchYs)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ys, nil
+}
+
+// callBatch calls fn for the batch at index b, verifying the number of results.
+func callBatch[X, Y any](ctx context.Context, b, batchSize int, batch []X, fn func(context.Context, []X) ([]Y, error)) ([]Y, error) {
+	ys, err := fn(ctx, batch)
+	if err == nil && len(ys) != len(batch) {
+		err = fmt.Errorf("%w: got %d, want %d", ErrBatchResults, len(ys), len(batch))
+	}
+	if err != nil {
+		return nil, newBatchError(b, batchSize, len(batch), err)
+	}
+	return ys, nil
+}
+
+// newBatchError returns a BatchError for the batch at index b, containing n elements.
+func newBatchError(b, batchSize, n int, err error) *BatchError {
+	start := b * batchSize
+	return &BatchError{Batch: b, Start: start, End: start + n, Err: err}
+}
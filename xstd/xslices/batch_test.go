@@ -0,0 +1,184 @@
+package xslices
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMapBatched(t *testing.T) {
+	atoiBatch := func(_ context.Context, ss []string) ([]int, error) {
+		return MapErr(ss, strconv.Atoi)
+	}
+
+	tests := []struct {
+		name          string
+		in            []string
+		batchSize     int
+		fn            func(context.Context, []string) ([]int, error)
+		want          []int
+		wantParallel  []int
+		wantErr       string
+		wantBatchErr  *BatchError
+		wantErrIsSize bool
+	}{
+		{
+			name:      "nil",
+			in:        nil,
+			batchSize: 2,
+			fn:        atoiBatch,
+			want:      nil,
+		},
+		{
+			name:         "empty",
+			in:           []string{},
+			batchSize:    2,
+			fn:           atoiBatch,
+			want:         []int{},
+			wantParallel: []int{},
+		},
+		{
+			name:         "exact batches",
+			in:           []string{"1", "2", "3", "4"},
+			batchSize:    2,
+			fn:           atoiBatch,
+			want:         []int{1, 2, 3, 4},
+			wantParallel: []int{1, 2, 3, 4},
+		},
+		{
+			name:         "partial last batch",
+			in:           []string{"1", "2", "3", "4", "5"},
+			batchSize:    2,
+			fn:           atoiBatch,
+			want:         []int{1, 2, 3, 4, 5},
+			wantParallel: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:         "batch larger than input",
+			in:           []string{"1", "2"},
+			batchSize:    10,
+			fn:           atoiBatch,
+			want:         []int{1, 2},
+			wantParallel: []int{1, 2},
+		},
+		{
+			name:         "err",
+			in:           []string{"1", "2", "3", "err", "5"},
+			batchSize:    2,
+			fn:           atoiBatch,
+			want:         []int{1, 2},
+			wantErr:      "batch 1 [2, 4): index 1: strconv.Atoi",
+			wantBatchErr: &BatchError{Batch: 1, Start: 2, End: 4},
+		},
+		{
+			name:      "wrong number of results",
+			in:        []string{"1", "2", "3", "4", "5"},
+			batchSize: 2,
+			fn: func(ctx context.Context, ss []string) ([]int, error) {
+				ys, err := atoiBatch(ctx, ss)
+				if len(ss) < 2 {
+					ys = append(ys, 0)
+				}
+				return ys, err
+			},
+			want:          []int{1, 2, 3, 4},
+			wantErr:       "batch 2 [4, 5): batch returned wrong number of results: got 2, want 1",
+			wantBatchErr:  &BatchError{Batch: 2, Start: 4, End: 5},
+			wantErrIsSize: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(t *testing.T, want []int, got []int, err error) {
+				assertErr(t, tt.wantErr, err)
+				assertEq(t, want, got)
+
+				if tt.wantBatchErr == nil {
+					return
+				}
+				var batchErr *BatchError
+				assertEq(t, true, errors.As(err, &batchErr))
+				assertEq(t, tt.wantBatchErr.Batch, batchErr.Batch)
+				assertEq(t, tt.wantBatchErr.Start, batchErr.Start)
+				assertEq(t, tt.wantBatchErr.End, batchErr.End)
+				assertEq(t, tt.wantErrIsSize, errors.Is(err, ErrBatchResults))
+
+				// The batch error also matches an IndexError for the start of the batch.
+				var indexErr *IndexError
+				assertEq(t, true, errors.As(err, &indexErr))
+				assertEq(t, tt.wantBatchErr.Start, indexErr.Index)
+				assertEq(t, batchErr.Err, indexErr.Err)
+			}
+
+			got, err := MapBatched(context.Background(), tt.in, tt.batchSize, tt.fn)
+			check(t, tt.want, got, err)
+
+			for _, concurrency := range []int{0, 1, 3} {
+				got, err := MapBatchedParallel(context.Background(), tt.in, tt.batchSize, tt.fn, ParallelOptions{Concurrency: concurrency})
+				check(t, tt.wantParallel, got, err)
+			}
+		})
+	}
+
+	t.Run("invalid batch size", func(t *testing.T) {
+		assertPanics(t, func() { MapBatched(context.Background(), []string{"1"}, 0, atoiBatch) })
+		assertPanics(t, func() { MapBatchedParallel(context.Background(), []string{"1"}, -1, atoiBatch, ParallelOptions{}) })
+	})
+}
+
+func TestMapBatched_Calls(t *testing.T) {
+	xs := make([]int, 95)
+	for i := range xs {
+		xs[i] = i
+	}
+
+	var calls atomic.Int32
+	double := func(_ context.Context, batch []int) ([]int, error) {
+		calls.Add(1)
+		if len(batch) > 10 {
+			t.Errorf("batch size %v exceeds limit", len(batch))
+		}
+		return Map(batch, func(x int) int { return x * 2 }), nil
+	}
+
+	check := func(t *testing.T, got []int, err error) {
+		assertErr(t, "", err)
+		for i, y := range got {
+			assertEq(t, i*2, y)
+		}
+		assertEq(t, int32(10), calls.Swap(0))
+	}
+
+	got, err := MapBatched(context.Background(), xs, 10, double)
+	check(t, got, err)
+
+	got, err = MapBatchedParallel(context.Background(), xs, 10, double, ParallelOptions{Concurrency: 4})
+	check(t, got, err)
+}
+
+func TestMapBatchedParallel_Observer(t *testing.T) {
+	obs := &recordingObserver{}
+	clock := &fakeClock{}
+	fn := func(_ context.Context, ss []string) ([]int, error) {
+		clock.After(time.Second)
+		return MapErr(ss, strconv.Atoi)
+	}
+
+	got, err := MapBatchedParallel(context.Background(), []string{"1", "2", "3", "err", "5"}, 2, fn, ParallelOptions{
+		Concurrency: 1,
+		Observer:    obs,
+		Clock:       clock,
+	})
+	assertErr(t, "batch 1 [2, 4): index 1: strconv.Atoi", err)
+	assertEq(t, []int(nil), got)
+
+	// Indexes are batch indexes, and the error for the failed batch is the BatchError.
+	assertEq(t, []string{
+		"start 0", "done 0 1s <nil>",
+		"start 1", `done 1 1s batch 1 [2, 4): index 1: strconv.Atoi: parsing "err": invalid syntax`,
+	}, obs.calls)
+}
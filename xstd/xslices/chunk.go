@@ -0,0 +1,46 @@
+package xslices
+
+import "fmt"
+
+// Chunk splits a slice into consecutive chunks of size elements.
+// The last chunk may have fewer elements.
+// It panics if size is not positive.
+//
+// Chunks share the underlying array of xs, but have their capacity clipped,
+// so appending to a chunk does not modify other chunks.
+func Chunk[X any](xs []X, size int) [][]X {
+	if size <= 0 {
+		panic(fmt.Sprintf("xslices: invalid chunk size %d", size))
+	}
+	if xs == nil {
+		return nil
+	}
+
+	chunks := make([][]X, 0, (len(xs)+size-1)/size)
+	for start := 0; start < len(xs); start += size {
+		end := min(start+size, len(xs))
+		chunks = append(chunks, xs[start:end:end])
+	}
+	return chunks
+}
+
+// Window returns all overlapping windows of size consecutive elements, in order.
+// If xs has fewer than size elements, no windows are returned.
+// It panics if size is not positive.
+//
+// Windows share the underlying array of xs, but have their capacity clipped,
+// so appending to a window does not modify other windows.
+func Window[X any](xs []X, size int) [][]X {
+	if size <= 0 {
+		panic(fmt.Sprintf("xslices: invalid window size %d", size))
+	}
+	if xs == nil {
+		return nil
+	}
+
+	windows := make([][]X, 0, max(len(xs)-size+1, 0))
+	for start := 0; start+size <= len(xs); start++ {
+		windows = append(windows, xs[start:start+size:start+size])
+	}
+	return windows
+}
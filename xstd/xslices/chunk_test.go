@@ -0,0 +1,121 @@
+package xslices
+
+import "testing"
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		size int
+		want [][]int
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			size: 2,
+			want: nil,
+		},
+		{
+			name: "empty",
+			in:   []int{},
+			size: 2,
+			want: [][]int{},
+		},
+		{
+			name: "exact",
+			in:   []int{1, 2, 3, 4},
+			size: 2,
+			want: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name: "remainder",
+			in:   []int{1, 2, 3, 4, 5},
+			size: 2,
+			want: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name: "size larger than slice",
+			in:   []int{1, 2},
+			size: 5,
+			want: [][]int{{1, 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertEq(t, tt.want, Chunk(tt.in, tt.size))
+		})
+	}
+
+	t.Run("append does not overwrite", func(t *testing.T) {
+		xs := []int{1, 2, 3, 4}
+		chunks := Chunk(xs, 2)
+		_ = append(chunks[0], 100)
+		assertEq(t, []int{1, 2, 3, 4}, xs)
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		assertPanics(t, func() { Chunk([]int{1}, 0) })
+	})
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		size int
+		want [][]int
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			size: 2,
+			want: nil,
+		},
+		{
+			name: "empty",
+			in:   []int{},
+			size: 2,
+			want: [][]int{},
+		},
+		{
+			name: "fewer than size",
+			in:   []int{1, 2},
+			size: 3,
+			want: [][]int{},
+		},
+		{
+			name: "exact",
+			in:   []int{1, 2, 3},
+			size: 3,
+			want: [][]int{{1, 2, 3}},
+		},
+		{
+			name: "overlapping",
+			in:   []int{1, 2, 3, 4},
+			size: 2,
+			want: [][]int{{1, 2}, {2, 3}, {3, 4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertEq(t, tt.want, Window(tt.in, tt.size))
+		})
+	}
+
+	t.Run("invalid size", func(t *testing.T) {
+		assertPanics(t, func() { Window([]int{1}, -1) })
+	})
+}
+
+func assertPanics(t testing.TB, fn func()) {
+	t.Helper()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("assertPanics failed, function did not panic")
+		}
+	}()
+	fn()
+}
@@ -0,0 +1,312 @@
+package xslices
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EditOp is the operation of an [Edit].
+type EditOp int
+
+// EditOp values.
+const (
+	// EditEqual is a run of elements that are in both slices.
+	EditEqual EditOp = iota
+
+	// EditDelete is a run of elements only in the first slice.
+	EditDelete
+
+	// EditInsert is a run of elements only in the second slice.
+	EditInsert
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case EditEqual:
+		return "equal"
+	case EditDelete:
+		return "delete"
+	case EditInsert:
+		return "insert"
+	default:
+		return fmt.Sprintf("EditOp(%d)", int(op))
+	}
+}
+
+// Edit is a run of consecutive elements with the same operation in an edit script.
+// The run covers a[AStart:AEnd] and b[BStart:BEnd], where deletes have an empty range in b,
+// and inserts have an empty range in a.
+type Edit struct {
+	Op           EditOp
+	AStart, AEnd int
+	BStart, BEnd int
+}
+
+// Diff returns a minimal edit script that transforms a into b,
+// as runs of equal, deleted and inserted elements, using the Myers diff algorithm.
+// It takes O((n+m)d) time, where d is the number of deleted and inserted elements,
+// and O(n+m) memory, using the linear space refinement of the algorithm.
+// Within each change, deletes are before inserts.
+func Diff[T comparable](a, b []T) []Edit {
+	return DiffFunc(a, b, func(x, y T) bool {
+		return x == y
+	})
+}
+
+// DiffFunc is similar to [Diff], but compares elements using eq.
+func DiffFunc[T any](a, b []T, eq func(T, T) bool) []Edit {
+	// Each call to middleSnake uses diagonals in [-(n+m)/2-1, (n+m)/2+1].
+	size := (len(a)+len(b))/2 + 2
+	d := &differ[T]{
+		a:  a,
+		b:  b,
+		eq: eq,
+		vf: make([]int, 2*size+1),
+		vb: make([]int, 2*size+1),
+	}
+	d.diff(0, len(a), 0, len(b))
+	d.script.flush()
+	return d.script.edits
+}
+
+// differ computes the edit script between a and b using the linear space Myers algorithm,
+// which recursively splits the problem on the middle snake of an optimal path.
+type differ[T any] struct {
+	a, b   []T
+	eq     func(T, T) bool
+	script editScript
+
+	// vf and vb hold the furthest x reached on each diagonal k by the forward and backward searches,
+	// indexed by offset+k. They're reused by each call to middleSnake.
+	vf, vb []int
+}
+
+// diff appends the edits to transform a[aLo:aHi] into b[bLo:bHi].
+func (d *differ[T]) diff(aLo, aHi, bLo, bHi int) {
+	// Trim the common prefix and suffix, which are common for similar slices.
+	var prefix int
+	for aLo+prefix < aHi && bLo+prefix < bHi && d.eq(d.a[aLo+prefix], d.b[bLo+prefix]) {
+		prefix++
+	}
+	var suffix int
+	for aHi-suffix > aLo+prefix && bHi-suffix > bLo+prefix && d.eq(d.a[aHi-1-suffix], d.b[bHi-1-suffix]) {
+		suffix++
+	}
+
+	d.script.add(EditEqual, prefix)
+	aLo, bLo = aLo+prefix, bLo+prefix
+	aHi, bHi = aHi-suffix, bHi-suffix
+
+	if aLo == aHi || bLo == bHi {
+		d.script.add(EditDelete, aHi-aLo)
+		d.script.add(EditInsert, bHi-bLo)
+	} else {
+		// Since the common prefix and suffix are trimmed, the middle snake is strictly inside the range,
+		// so both halves are smaller.
+		x, y, u, v := d.middleSnake(aLo, aHi, bLo, bHi)
+		d.diff(aLo, x, bLo, y)
+		d.script.add(EditEqual, u-x)
+		d.diff(u, aHi, v, bHi)
+	}
+
+	d.script.add(EditEqual, suffix)
+}
+
+// middleSnake returns the snake from (x, y) to (u, v) in the middle of an optimal path
+// from (aLo, bLo) to (aHi, bHi), by searching forward from the start and backward from the end
+// until the searches overlap.
+func (d *differ[T]) middleSnake(aLo, aHi, bLo, bHi int) (x, y, u, v int) {
+	n, m := aHi-aLo, bHi-bLo
+	delta := n - m
+	odd := delta%2 != 0
+	maxD := (n + m + 1) / 2
+
+	// The backward search uses coordinates reversed from the end, so backward diagonal k
+	// corresponds to forward diagonal delta-k.
+	offset := len(d.vf) / 2
+	vf, vb := d.vf, d.vb
+	vf[offset+1], vb[offset+1] = 0, 0
+
+	for step := 0; step <= maxD; step++ {
+		for k := -step; k <= step; k += 2 {
+			var x int
+			if k == -step || (k != step && vf[offset+k-1] < vf[offset+k+1]) {
+				x = vf[offset+k+1] // down: insert from b.
+			} else {
+				x = vf[offset+k-1] + 1 // right: delete from a.
+			}
+			y := x - k
+
+			x0, y0 := x, y
+			for x < n && y < m && d.eq(d.a[aLo+x], d.b[bLo+y]) {
+				x++
+				y++
+			}
+			vf[offset+k] = x
+
+			if odd && delta-k >= -(step-1) && delta-k <= step-1 && x+vb[offset+delta-k] >= n {
+				return aLo + x0, bLo + y0, aLo + x, bLo + y
+			}
+		}
+
+		for k := -step; k <= step; k += 2 {
+			var x int
+			if k == -step || (k != step && vb[offset+k-1] < vb[offset+k+1]) {
+				x = vb[offset+k+1]
+			} else {
+				x = vb[offset+k-1] + 1
+			}
+			y := x - k
+
+			x0, y0 := x, y
+			for x < n && y < m && d.eq(d.a[aHi-1-x], d.b[bHi-1-y]) {
+				x++
+				y++
+			}
+			vb[offset+k] = x
+
+			if !odd && delta-k >= -step && delta-k <= step && x+vf[offset+delta-k] >= n {
+				return aHi - x, bHi - y, aHi - x0, bHi - y0
+			}
+		}
+	}
+
+	panic("xslices: diff searches did not overlap")
+}
+
+// editScript builds an edit script, coalescing runs of the same operation,
+// and ordering deletes before inserts within each change.
+type editScript struct {
+	edits []Edit
+	a, b  int // current position in a and b.
+
+	// pending deletes and inserts, added on the next equal run or flush.
+	deletes, inserts int
+}
+
+// add adds count elements with the specified operation to the script.
+func (s *editScript) add(op EditOp, count int) {
+	switch op {
+	case EditDelete:
+		s.deletes += count
+	case EditInsert:
+		s.inserts += count
+	default:
+		if count > 0 {
+			s.flush()
+			s.append(op, count)
+		}
+	}
+}
+
+// flush adds any pending deletes and inserts.
+func (s *editScript) flush() {
+	s.append(EditDelete, s.deletes)
+	s.append(EditInsert, s.inserts)
+	s.deletes, s.inserts = 0, 0
+}
+
+func (s *editScript) append(op EditOp, count int) {
+	if count == 0 {
+		return
+	}
+
+	aStart, bStart := s.a, s.b
+	if op != EditInsert {
+		s.a += count
+	}
+	if op != EditDelete {
+		s.b += count
+	}
+
+	if last := len(s.edits) - 1; last >= 0 && s.edits[last].Op == op {
+		s.edits[last].AEnd, s.edits[last].BEnd = s.a, s.b
+		return
+	}
+	s.edits = append(s.edits, Edit{Op: op, AStart: aStart, AEnd: s.a, BStart: bStart, BEnd: s.b})
+}
+
+// FormatUnified formats the edit script for a and b, as returned by [Diff], in the unified diff format.
+// Each hunk includes up to contextLines equal elements around changes, and elements are formatted using format.
+// If format is nil, elements are formatted using [fmt.Sprint].
+// If there are no changes, an empty string is returned.
+// It panics if contextLines is negative.
+func FormatUnified[T any](a, b []T, edits []Edit, contextLines int, format func(T) string) string {
+	if contextLines < 0 {
+		panic(fmt.Sprintf("xslices: invalid context %d", contextLines))
+	}
+	if format == nil {
+		format = func(x T) string { return fmt.Sprint(x) }
+	}
+
+	// Expand the edits into lines, so hunks can be split on equal lines.
+	type line struct {
+		op   EditOp
+		a, b int // index of the element in a and b.
+	}
+	var (
+		lines   []line
+		changed []int // indexes of changed lines.
+	)
+	for _, e := range edits {
+		for i := range max(e.AEnd-e.AStart, e.BEnd-e.BStart) {
+			l := line{op: e.Op, a: e.AStart, b: e.BStart}
+			if e.Op != EditInsert {
+				l.a += i
+			}
+			if e.Op != EditDelete {
+				l.b += i
+			}
+			if e.Op != EditEqual {
+				changed = append(changed, len(lines))
+			}
+			lines = append(lines, l)
+		}
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(changed); {
+		// Extend the hunk while the gap to the next change is small enough to share context.
+		j := i
+		for j+1 < len(changed) && changed[j+1]-changed[j]-1 <= 2*contextLines {
+			j++
+		}
+
+		start := max(changed[i]-contextLines, 0)
+		end := min(changed[j]+contextLines+1, len(lines))
+		hunk := lines[start:end]
+
+		var aCount, bCount int
+		for _, l := range hunk {
+			if l.op != EditInsert {
+				aCount++
+			}
+			if l.op != EditDelete {
+				bCount++
+			}
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(hunk[0].a, aCount), hunkRange(hunk[0].b, bCount))
+
+		for _, l := range hunk {
+			switch l.op {
+			case EditEqual:
+				sb.WriteString(" " + format(a[l.a]) + "\n")
+			case EditDelete:
+				sb.WriteString("-" + format(a[l.a]) + "\n")
+			case EditInsert:
+				sb.WriteString("+" + format(b[l.b]) + "\n")
+			}
+		}
+		i = j + 1
+	}
+	return sb.String()
+}
+
+// hunkRange formats the range of a hunk, where start is 0-based.
+// Empty ranges refer to the element before the hunk, matching GNU diff.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
@@ -0,0 +1,314 @@
+package xslices
+
+import (
+	"math/rand/v2"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want []Edit
+	}{
+		{
+			name: "both empty",
+			want: nil,
+		},
+		{
+			name: "equal",
+			a:    "abc",
+			b:    "abc",
+			want: []Edit{{Op: EditEqual, AStart: 0, AEnd: 3, BStart: 0, BEnd: 3}},
+		},
+		{
+			name: "all inserted",
+			b:    "ab",
+			want: []Edit{{Op: EditInsert, AStart: 0, AEnd: 0, BStart: 0, BEnd: 2}},
+		},
+		{
+			name: "all deleted",
+			a:    "ab",
+			want: []Edit{{Op: EditDelete, AStart: 0, AEnd: 2, BStart: 0, BEnd: 0}},
+		},
+		{
+			name: "replace middle",
+			a:    "abcd",
+			b:    "axyd",
+			want: []Edit{
+				{Op: EditEqual, AStart: 0, AEnd: 1, BStart: 0, BEnd: 1},
+				{Op: EditDelete, AStart: 1, AEnd: 3, BStart: 1, BEnd: 1},
+				{Op: EditInsert, AStart: 3, AEnd: 3, BStart: 1, BEnd: 3},
+				{Op: EditEqual, AStart: 3, AEnd: 4, BStart: 3, BEnd: 4},
+			},
+		},
+		{
+			name: "insert in middle",
+			a:    "ac",
+			b:    "abc",
+			want: []Edit{
+				{Op: EditEqual, AStart: 0, AEnd: 1, BStart: 0, BEnd: 1},
+				{Op: EditInsert, AStart: 1, AEnd: 1, BStart: 1, BEnd: 2},
+				{Op: EditEqual, AStart: 1, AEnd: 2, BStart: 2, BEnd: 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := strings.Split(tt.a, ""), strings.Split(tt.b, "")
+			got := Diff(a, b)
+			assertEq(t, tt.want, got)
+			checkEdits(t, a, b, got)
+		})
+	}
+}
+
+func TestDiff_Minimal(t *testing.T) {
+	// The classic example from the Myers paper has a minimal edit script of 5 changes.
+	a, b := strings.Split("ABCABBA", ""), strings.Split("CBABAC", "")
+	edits := Diff(a, b)
+	checkEdits(t, a, b, edits)
+	assertEq(t, 4, equalCount(edits))
+
+	for range 200 {
+		a, b := randomLetters(rand.IntN(30)), randomLetters(rand.IntN(30))
+		edits := Diff(a, b)
+		checkEdits(t, a, b, edits)
+		assertEq(t, lcsLen(a, b), equalCount(edits))
+	}
+}
+
+func TestDiff_LargeDifferent(t *testing.T) {
+	const n = 5000
+	a, b := make([]int, n), make([]int, n)
+	for i := range n {
+		a[i] = i
+		b[i] = -i - 1
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	edits := Diff(a, b)
+	runtime.ReadMemStats(&after)
+
+	assertEq(t, []Edit{
+		{Op: EditDelete, AStart: 0, AEnd: n, BStart: 0, BEnd: 0},
+		{Op: EditInsert, AStart: n, AEnd: n, BStart: 0, BEnd: n},
+	}, edits)
+
+	// Memory is linear in the input size, rather than quadratic in the number of changes.
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 1<<20 {
+		t.Fatalf("Diff allocated %v bytes, expected linear memory", allocated)
+	}
+}
+
+func TestDiffFunc(t *testing.T) {
+	a := [][]int{{1}, {2}, {3}}
+	b := [][]int{{1}, {3}, {4}}
+	eq := func(x, y []int) bool { return x[0] == y[0] }
+
+	got := DiffFunc(a, b, eq)
+	assertEq(t, []Edit{
+		{Op: EditEqual, AStart: 0, AEnd: 1, BStart: 0, BEnd: 1},
+		{Op: EditDelete, AStart: 1, AEnd: 2, BStart: 1, BEnd: 1},
+		{Op: EditEqual, AStart: 2, AEnd: 3, BStart: 1, BEnd: 2},
+		{Op: EditInsert, AStart: 3, AEnd: 3, BStart: 2, BEnd: 3},
+	}, got)
+}
+
+func TestEditOpString(t *testing.T) {
+	assertEq(t, "equal", EditEqual.String())
+	assertEq(t, "delete", EditDelete.String())
+	assertEq(t, "insert", EditInsert.String())
+	assertEq(t, "EditOp(5)", EditOp(5).String())
+}
+
+func TestFormatUnified(t *testing.T) {
+	lines := func(n int) []int {
+		xs := make([]int, n)
+		for i := range xs {
+			xs[i] = i + 1
+		}
+		return xs
+	}
+
+	tests := []struct {
+		name         string
+		a            []int
+		b            []int
+		contextLines int
+		want         string
+	}{
+		{
+			name:         "no changes",
+			a:            lines(3),
+			b:            lines(3),
+			contextLines: 3,
+			want:         "",
+		},
+		{
+			name:         "single change",
+			a:            lines(10),
+			b:            []int{1, 2, 3, 4, 50, 6, 7, 8, 9, 10},
+			contextLines: 2,
+			want: "" +
+				"@@ -3,5 +3,5 @@\n" +
+				" 3\n" +
+				" 4\n" +
+				"-5\n" +
+				"+50\n" +
+				" 6\n" +
+				" 7\n",
+		},
+		{
+			name:         "separate hunks",
+			a:            lines(10),
+			b:            []int{10, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			contextLines: 1,
+			want: "" +
+				"@@ -1,1 +1,2 @@\n" +
+				"+10\n" +
+				" 1\n" +
+				"@@ -9,2 +10,1 @@\n" +
+				" 9\n" +
+				"-10\n",
+		},
+		{
+			name:         "merged hunks",
+			a:            lines(5),
+			b:            []int{0, 1, 2, 3, 4},
+			contextLines: 3,
+			want: "" +
+				"@@ -1,5 +1,5 @@\n" +
+				"+0\n" +
+				" 1\n" +
+				" 2\n" +
+				" 3\n" +
+				" 4\n" +
+				"-5\n",
+		},
+		{
+			name:         "empty a",
+			b:            []int{1, 2},
+			contextLines: 3,
+			want: "" +
+				"@@ -0,0 +1,2 @@\n" +
+				"+1\n" +
+				"+2\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatUnified(tt.a, tt.b, Diff(tt.a, tt.b), tt.contextLines, nil)
+			assertEq(t, tt.want, got)
+		})
+	}
+
+	t.Run("custom format", func(t *testing.T) {
+		a, b := []int{1}, []int{2}
+		got := FormatUnified(a, b, Diff(a, b), 0, func(x int) string {
+			return "#" + strconv.Itoa(x)
+		})
+		assertEq(t, "@@ -1,1 +1,1 @@\n-#1\n+#2\n", got)
+	})
+
+	t.Run("negative context", func(t *testing.T) {
+		a, b := []int{1, 2, 3}, []int{1, 4, 3}
+		assertPanics(t, func() { FormatUnified(a, b, Diff(a, b), -1, nil) })
+	})
+}
+
+// checkEdits verifies that edits cover a and b contiguously, and transform a into b.
+func checkEdits[T comparable](t testing.TB, a, b []T, edits []Edit) {
+	t.Helper()
+
+	var (
+		ai, bi int
+		got    []T
+	)
+	for i, e := range edits {
+		if e.AStart != ai || e.BStart != bi {
+			t.Fatalf("edit %v %+v is not contiguous with position (%v, %v)", i, e, ai, bi)
+		}
+		if i > 0 && edits[i-1].Op == e.Op {
+			t.Fatalf("edit %v %+v was not coalesced with the previous edit", i, e)
+		}
+
+		switch e.Op {
+		case EditEqual:
+			assertEq(t, a[e.AStart:e.AEnd], b[e.BStart:e.BEnd])
+			got = append(got, a[e.AStart:e.AEnd]...)
+		case EditDelete:
+			assertEq(t, e.BStart, e.BEnd)
+		case EditInsert:
+			assertEq(t, e.AStart, e.AEnd)
+			got = append(got, b[e.BStart:e.BEnd]...)
+		}
+		ai, bi = e.AEnd, e.BEnd
+	}
+
+	assertEq(t, len(a), ai)
+	assertEq(t, len(b), bi)
+	assertEq(t, len(b), len(got))
+	for i := range got {
+		assertEq(t, b[i], got[i])
+	}
+}
+
+func equalCount(edits []Edit) int {
+	var n int
+	for _, e := range edits {
+		if e.Op == EditEqual {
+			n += e.AEnd - e.AStart
+		}
+	}
+	return n
+}
+
+// lcsLen returns the length of the longest common subsequence, which a minimal diff keeps equal.
+func lcsLen[T comparable](a, b []T) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				dp[i][j] = dp[i-1][j-1] + 1
+			} else {
+				dp[i][j] = max(dp[i-1][j], dp[i][j-1])
+			}
+		}
+	}
+	return dp[len(a)][len(b)]
+}
+
+func randomLetters(n int) []string {
+	xs := make([]string, n)
+	for i := range xs {
+		xs[i] = string(rune('a' + rand.IntN(4)))
+	}
+	return xs
+}
+
+func BenchmarkDiff(b *testing.B) {
+	xs := make([]int, 10000)
+	for i := range xs {
+		xs[i] = i
+	}
+	ys := make([]int, len(xs))
+	copy(ys, xs)
+	for i := 0; i < len(ys); i += 100 {
+		ys[i] = -1
+	}
+
+	for b.Loop() {
+		Diff(xs, ys)
+	}
+}
@@ -0,0 +1,213 @@
+package xslices
+
+import (
+	"context"
+	"slices"
+)
+
+// Filter returns a new slice with the elements for which fn returns true.
+func Filter[X any](xs []X, fn func(X) bool) []X {
+	if xs == nil {
+		return nil
+	}
+
+	filtered := make([]X, 0, len(xs))
+	for i := range xs {
+		if fn(xs[i]) {
+			filtered = append(filtered, xs[i])
+		}
+	}
+	return filtered
+}
+
+// FilterErr returns a new slice with the elements for which fn returns true.
+// The filter function may return an error which stops filtering.
+// The error is returned as an [*IndexError] with the elements filtered before the failed index.
+func FilterErr[X any](xs []X, fn func(X) (bool, error)) ([]X, error) {
+	return FilterCtx(context.Background(), xs, func(_ context.Context, x X) (bool, error) {
+		return fn(x)
+	})
+}
+
+// FilterCtx returns a new slice with the elements for which the context-aware fn returns true.
+// The filter function may return an error which stops filtering.
+// The error is returned as an [*IndexError] with the elements filtered before the failed index.
+// FilterCtx does not explicitly check for context errors, the filter function is expected to respect and propagate context errors.
+func FilterCtx[X any](ctx context.Context, xs []X, fn func(context.Context, X) (bool, error)) ([]X, error) {
+	if xs == nil {
+		return nil, nil
+	}
+
+	filtered := make([]X, 0, len(xs))
+	for i := range xs {
+		keep, err := fn(ctx, xs[i])
+		if err != nil {
+			return filtered, &IndexError{Index: i, Err: err}
+		}
+		if keep {
+			filtered = append(filtered, xs[i])
+		}
+	}
+	return filtered, nil
+}
+
+// FilterMap runs the mapping function to map a slice to a new slice,
+// only keeping results for which fn returns true.
+func FilterMap[X, Y any](xs []X, fn func(X) (Y, bool)) []Y {
+	if xs == nil {
+		return nil
+	}
+
+	ys := make([]Y, 0, len(xs))
+	for i := range xs {
+		if y, ok := fn(xs[i]); ok {
+			ys = append(ys, y)
+		}
+	}
+	return ys
+}
+
+// FilterMapErr runs the mapping function to map a slice to a new slice,
+// only keeping results for which fn returns true.
+// The mapping function may return an error which stops mapping.
+// The error is returned as an [*IndexError] with the results before the failed index.
+func FilterMapErr[X, Y any](xs []X, fn func(X) (Y, bool, error)) ([]Y, error) {
+	return FilterMapCtx(context.Background(), xs, func(_ context.Context, x X) (Y, bool, error) {
+		return fn(x)
+	})
+}
+
+// FilterMapCtx runs the context-aware mapping function to map a slice to a new slice,
+// only keeping results for which fn returns true.
+// The mapping function may return an error which stops mapping.
+// The error is returned as an [*IndexError] with the results before the failed index.
+// FilterMapCtx does not explicitly check for context errors, the mapping function is expected to respect and propagate context errors.
+func FilterMapCtx[X, Y any](ctx context.Context, xs []X, fn func(context.Context, X) (Y, bool, error)) ([]Y, error) {
+	if xs == nil {
+		return nil, nil
+	}
+
+	ys := make([]Y, 0, len(xs))
+	for i := range xs {
+		y, ok, err := fn(ctx, xs[i])
+		if err != nil {
+			return ys, &IndexError{Index: i, Err: err}
+		}
+		if ok {
+			ys = append(ys, y)
+		}
+	}
+	return ys, nil
+}
+
+// Partition splits a slice into the elements for which fn returns true, and the remaining elements.
+// The relative order of elements is preserved in both slices.
+func Partition[X any](xs []X, fn func(X) bool) (matched, unmatched []X) {
+	if xs == nil {
+		return nil, nil
+	}
+
+	p := newPartition[X](len(xs))
+	for i := range xs {
+		p.add(xs[i], fn(xs[i]))
+	}
+	return p.split()
+}
+
+// PartitionErr splits a slice into the elements for which fn returns true, and the remaining elements.
+// The partition function may return an error which stops partitioning.
+// The error is returned as an [*IndexError] with the elements partitioned before the failed index.
+func PartitionErr[X any](xs []X, fn func(X) (bool, error)) (matched, unmatched []X, _ error) {
+	return PartitionCtx(context.Background(), xs, func(_ context.Context, x X) (bool, error) {
+		return fn(x)
+	})
+}
+
+// PartitionCtx splits a slice into the elements for which the context-aware fn returns true, and the remaining elements.
+// The partition function may return an error which stops partitioning.
+// The error is returned as an [*IndexError] with the elements partitioned before the failed index.
+// PartitionCtx does not explicitly check for context errors, the partition function is expected to respect and propagate context errors.
+func PartitionCtx[X any](ctx context.Context, xs []X, fn func(context.Context, X) (bool, error)) (matched, unmatched []X, _ error) {
+	if xs == nil {
+		return nil, nil, nil
+	}
+
+	p := newPartition[X](len(xs))
+	for i := range xs {
+		match, err := fn(ctx, xs[i])
+		if err != nil {
+			matched, unmatched = p.split()
+			return matched, unmatched, &IndexError{Index: i, Err: err}
+		}
+		p.add(xs[i], match)
+	}
+	matched, unmatched = p.split()
+	return matched, unmatched, nil
+}
+
+// partition shares a single buffer between matched and unmatched elements,
+// filling matched elements from the start, and unmatched elements from the end.
+type partition[X any] struct {
+	buf                []X
+	matched, unmatched int
+}
+
+func newPartition[X any](n int) *partition[X] {
+	return &partition[X]{buf: make([]X, n)}
+}
+
+func (p *partition[X]) add(x X, match bool) {
+	if match {
+		p.buf[p.matched] = x
+		p.matched++
+	} else {
+		p.unmatched++
+		p.buf[len(p.buf)-p.unmatched] = x
+	}
+}
+
+// split returns the matched and unmatched elements in their original order.
+// Capacities are clipped, so appending to one slice does not modify the other.
+func (p *partition[X]) split() (matched, unmatched []X) {
+	unmatched = p.buf[len(p.buf)-p.unmatched:]
+	slices.Reverse(unmatched)
+	return p.buf[:p.matched:p.matched], unmatched
+}
+
+// Count returns the number of elements for which fn returns true.
+func Count[X any](xs []X, fn func(X) bool) int {
+	var n int
+	for i := range xs {
+		if fn(xs[i]) {
+			n++
+		}
+	}
+	return n
+}
+
+// CountErr returns the number of elements for which fn returns true.
+// The count function may return an error which stops counting.
+// The error is returned as an [*IndexError] with the count before the failed index.
+func CountErr[X any](xs []X, fn func(X) (bool, error)) (int, error) {
+	return CountCtx(context.Background(), xs, func(_ context.Context, x X) (bool, error) {
+		return fn(x)
+	})
+}
+
+// CountCtx returns the number of elements for which the context-aware fn returns true.
+// The count function may return an error which stops counting.
+// The error is returned as an [*IndexError] with the count before the failed index.
+// CountCtx does not explicitly check for context errors, the count function is expected to respect and propagate context errors.
+func CountCtx[X any](ctx context.Context, xs []X, fn func(context.Context, X) (bool, error)) (int, error) {
+	var n int
+	for i := range xs {
+		match, err := fn(ctx, xs[i])
+		if err != nil {
+			return n, &IndexError{Index: i, Err: err}
+		}
+		if match {
+			n++
+		}
+	}
+	return n, nil
+}
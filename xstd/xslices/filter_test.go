@@ -0,0 +1,248 @@
+package xslices
+
+import (
+	"context"
+	"strconv"
+	"testing"
+)
+
+// isEven parses s and returns if it's even, used to test functions with error variants.
+func isEven(s string) (bool, error) {
+	n, err := strconv.Atoi(s)
+	return n%2 == 0, err
+}
+
+func isEvenCtx(_ context.Context, s string) (bool, error) {
+	return isEven(s)
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "empty",
+			in:   []string{},
+			want: []string{},
+		},
+		{
+			name: "none match",
+			in:   []string{"1", "3"},
+			want: []string{},
+		},
+		{
+			name: "some match",
+			in:   []string{"1", "2", "3", "4"},
+			want: []string{"2", "4"},
+		},
+		{
+			name:    "err",
+			in:      []string{"1", "2", "err", "4"},
+			want:    []string{"2"},
+			wantErr: "index 2: strconv.Atoi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == "" {
+				got := Filter(tt.in, func(s string) bool {
+					even, _ := isEven(s)
+					return even
+				})
+				assertEq(t, tt.want, got)
+			}
+
+			got, err := FilterErr(tt.in, isEven)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+
+			got, err = FilterCtx(context.Background(), tt.in, isEvenCtx)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+		})
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []string
+		want    []int
+		wantErr string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "empty",
+			in:   []string{},
+			want: []int{},
+		},
+		{
+			name: "some match",
+			in:   []string{"1", "2", "3", "4"},
+			want: []int{2, 4},
+		},
+		{
+			name:    "err",
+			in:      []string{"1", "2", "err", "4"},
+			want:    []int{2},
+			wantErr: "index 2: strconv.Atoi",
+		},
+	}
+
+	atoiEven := func(s string) (int, bool, error) {
+		n, err := strconv.Atoi(s)
+		return n, n%2 == 0, err
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == "" {
+				got := FilterMap(tt.in, func(s string) (int, bool) {
+					n, ok, _ := atoiEven(s)
+					return n, ok
+				})
+				assertEq(t, tt.want, got)
+			}
+
+			got, err := FilterMapErr(tt.in, atoiEven)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+
+			got, err = FilterMapCtx(context.Background(), tt.in, func(_ context.Context, s string) (int, bool, error) {
+				return atoiEven(s)
+			})
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+		})
+	}
+}
+
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            []string
+		wantMatched   []string
+		wantUnmatched []string
+		wantErr       string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+		},
+		{
+			name:          "empty",
+			in:            []string{},
+			wantMatched:   []string{},
+			wantUnmatched: []string{},
+		},
+		{
+			name:          "mixed",
+			in:            []string{"1", "2", "3", "4", "5"},
+			wantMatched:   []string{"2", "4"},
+			wantUnmatched: []string{"1", "3", "5"},
+		},
+		{
+			name:          "err",
+			in:            []string{"1", "2", "err", "4"},
+			wantMatched:   []string{"2"},
+			wantUnmatched: []string{"1"},
+			wantErr:       "index 2: strconv.Atoi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == "" {
+				matched, unmatched := Partition(tt.in, func(s string) bool {
+					even, _ := isEven(s)
+					return even
+				})
+				assertEq(t, tt.wantMatched, matched)
+				assertEq(t, tt.wantUnmatched, unmatched)
+			}
+
+			matched, unmatched, err := PartitionErr(tt.in, isEven)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.wantMatched, matched)
+			assertEq(t, tt.wantUnmatched, unmatched)
+
+			matched, unmatched, err = PartitionCtx(context.Background(), tt.in, isEvenCtx)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.wantMatched, matched)
+			assertEq(t, tt.wantUnmatched, unmatched)
+		})
+	}
+}
+
+func TestPartition_SharedBuffer(t *testing.T) {
+	xs := []int{1, 2, 3, 4, 5}
+	isOdd := func(x int) bool { return x%2 == 1 }
+
+	allocs := testing.AllocsPerRun(10, func() { Partition(xs, isOdd) })
+	assertEq(t, 1.0, allocs)
+
+	// Appending to one slice does not modify the other.
+	matched, unmatched := Partition(xs, isOdd)
+	matched = append(matched, 7)
+	assertEq(t, []int{1, 3, 5, 7}, matched)
+	assertEq(t, []int{2, 4}, unmatched)
+}
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []string
+		want    int
+		wantErr string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: 0,
+		},
+		{
+			name: "some match",
+			in:   []string{"1", "2", "3", "4"},
+			want: 2,
+		},
+		{
+			name:    "err",
+			in:      []string{"2", "4", "err", "6"},
+			want:    2,
+			wantErr: "index 2: strconv.Atoi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == "" {
+				got := Count(tt.in, func(s string) bool {
+					even, _ := isEven(s)
+					return even
+				})
+				assertEq(t, tt.want, got)
+			}
+
+			got, err := CountErr(tt.in, isEven)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+
+			got, err = CountCtx(context.Background(), tt.in, isEvenCtx)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package xslices
+
+import "context"
+
+// FlatMap runs the mapping function to map each element to a slice,
+// and concatenates the results into a new slice.
+func FlatMap[X, Y any](xs []X, fn func(X) []Y) []Y {
+	if xs == nil {
+		return nil
+	}
+
+	ys := make([]Y, 0, len(xs))
+	for i := range xs {
+		ys = append(ys, fn(xs[i])...)
+	}
+	return ys
+}
+
+// FlatMapErr runs the mapping function to map each element to a slice,
+// and concatenates the results into a new slice.
+// The mapping function may return an error which stops mapping.
+// The error is returned as an [*IndexError] with the results before the failed index.
+func FlatMapErr[X, Y any](xs []X, fn func(X) ([]Y, error)) ([]Y, error) {
+	return FlatMapCtx(context.Background(), xs, func(_ context.Context, x X) ([]Y, error) {
+		return fn(x)
+	})
+}
+
+// FlatMapCtx runs the context-aware mapping function to map each element to a slice,
+// and concatenates the results into a new slice.
+// The mapping function may return an error which stops mapping.
+// The error is returned as an [*IndexError] with the results before the failed index.
+// FlatMapCtx does not explicitly check for context errors, the mapping function is expected to respect and propagate context errors.
+func FlatMapCtx[X, Y any](ctx context.Context, xs []X, fn func(context.Context, X) ([]Y, error)) ([]Y, error) {
+	if xs == nil {
+		return nil, nil
+	}
+
+	ys := make([]Y, 0, len(xs))
+	for i := range xs {
+		mapped, err := fn(ctx, xs[i])
+		if err != nil {
+			return ys, &IndexError{Index: i, Err: err}
+		}
+		ys = append(ys, mapped...)
+	}
+	return ys, nil
+}
@@ -0,0 +1,68 @@
+package xslices
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFlatMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []string
+		want    []int
+		wantErr string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "empty",
+			in:   []string{},
+			want: []int{},
+		},
+		{
+			name: "multiple elements",
+			in:   []string{"1,2", "", "3"},
+			want: []int{1, 2, 3},
+		},
+		{
+			name:    "err",
+			in:      []string{"1,2", "3,err", "4"},
+			want:    []int{1, 2},
+			wantErr: "index 1: strconv.Atoi",
+		},
+	}
+
+	splitInts := func(s string) ([]int, error) {
+		if s == "" {
+			return nil, nil
+		}
+		return MapErr(strings.Split(s, ","), strconv.Atoi)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == "" {
+				got := FlatMap(tt.in, func(s string) []int {
+					ns, _ := splitInts(s)
+					return ns
+				})
+				assertEq(t, tt.want, got)
+			}
+
+			got, err := FlatMapErr(tt.in, splitInts)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+
+			got, err = FlatMapCtx(context.Background(), tt.in, func(_ context.Context, s string) ([]int, error) {
+				return splitInts(s)
+			})
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+		})
+	}
+}
@@ -1,3 +1,8 @@
 module go.prashantv.com/xstd/xslices
 
 go 1.24
+
+require (
+	go.prashantv.com/container/set v0.1.0
+	go.prashantv.com/xstd/xsync v0.1.0
+)
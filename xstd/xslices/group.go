@@ -0,0 +1,94 @@
+package xslices
+
+import "context"
+
+// KeyBy returns a map of elements keyed by the result of fn.
+// If multiple elements have the same key, the last element is used.
+func KeyBy[X any, K comparable](xs []X, fn func(X) K) map[K]X {
+	if xs == nil {
+		return nil
+	}
+
+	m := make(map[K]X, len(xs))
+	for i := range xs {
+		m[fn(xs[i])] = xs[i]
+	}
+	return m
+}
+
+// KeyByErr returns a map of elements keyed by the result of fn.
+// If multiple elements have the same key, the last element is used.
+// The key function may return an error which stops mapping.
+// The error is returned as an [*IndexError] with the elements keyed before the failed index.
+func KeyByErr[X any, K comparable](xs []X, fn func(X) (K, error)) (map[K]X, error) {
+	return KeyByCtx(context.Background(), xs, func(_ context.Context, x X) (K, error) {
+		return fn(x)
+	})
+}
+
+// KeyByCtx returns a map of elements keyed by the result of the context-aware fn.
+// If multiple elements have the same key, the last element is used.
+// The key function may return an error which stops mapping.
+// The error is returned as an [*IndexError] with the elements keyed before the failed index.
+// KeyByCtx does not explicitly check for context errors, the key function is expected to respect and propagate context errors.
+func KeyByCtx[X any, K comparable](ctx context.Context, xs []X, fn func(context.Context, X) (K, error)) (map[K]X, error) {
+	if xs == nil {
+		return nil, nil
+	}
+
+	m := make(map[K]X, len(xs))
+	for i := range xs {
+		k, err := fn(ctx, xs[i])
+		if err != nil {
+			return m, &IndexError{Index: i, Err: err}
+		}
+		m[k] = xs[i]
+	}
+	return m, nil
+}
+
+// GroupBy returns a map of elements grouped by the result of fn.
+// Elements in each group are in the same order as xs.
+func GroupBy[X any, K comparable](xs []X, fn func(X) K) map[K][]X {
+	if xs == nil {
+		return nil
+	}
+
+	m := make(map[K][]X)
+	for i := range xs {
+		k := fn(xs[i])
+		m[k] = append(m[k], xs[i])
+	}
+	return m
+}
+
+// GroupByErr returns a map of elements grouped by the result of fn.
+// Elements in each group are in the same order as xs.
+// The key function may return an error which stops grouping.
+// The error is returned as an [*IndexError] with the elements grouped before the failed index.
+func GroupByErr[X any, K comparable](xs []X, fn func(X) (K, error)) (map[K][]X, error) {
+	return GroupByCtx(context.Background(), xs, func(_ context.Context, x X) (K, error) {
+		return fn(x)
+	})
+}
+
+// GroupByCtx returns a map of elements grouped by the result of the context-aware fn.
+// Elements in each group are in the same order as xs.
+// The key function may return an error which stops grouping.
+// The error is returned as an [*IndexError] with the elements grouped before the failed index.
+// GroupByCtx does not explicitly check for context errors, the key function is expected to respect and propagate context errors.
+func GroupByCtx[X any, K comparable](ctx context.Context, xs []X, fn func(context.Context, X) (K, error)) (map[K][]X, error) {
+	if xs == nil {
+		return nil, nil
+	}
+
+	m := make(map[K][]X)
+	for i := range xs {
+		k, err := fn(ctx, xs[i])
+		if err != nil {
+			return m, &IndexError{Index: i, Err: err}
+		}
+		m[k] = append(m[k], xs[i])
+	}
+	return m, nil
+}
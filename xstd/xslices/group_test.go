@@ -0,0 +1,121 @@
+package xslices
+
+import (
+	"context"
+	"strconv"
+	"testing"
+)
+
+func TestKeyBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []string
+		want    map[int]string
+		wantErr string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "empty",
+			in:   []string{},
+			want: map[int]string{},
+		},
+		{
+			name: "unique keys",
+			in:   []string{"a", "bb", "ccc"},
+			want: map[int]string{1: "a", 2: "bb", 3: "ccc"},
+		},
+		{
+			name: "duplicate keys uses last",
+			in:   []string{"a", "bb", "c"},
+			want: map[int]string{1: "c", 2: "bb"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := KeyBy(tt.in, func(s string) int { return len(s) })
+			assertEq(t, tt.want, got)
+
+			got, err := KeyByErr(tt.in, func(s string) (int, error) { return len(s), nil })
+			assertErr(t, "", err)
+			assertEq(t, tt.want, got)
+
+			got, err = KeyByCtx(context.Background(), tt.in, func(_ context.Context, s string) (int, error) { return len(s), nil })
+			assertErr(t, "", err)
+			assertEq(t, tt.want, got)
+		})
+	}
+
+	t.Run("err", func(t *testing.T) {
+		in := []string{"1", "2", "err", "3"}
+		want := map[int]string{1: "1", 2: "2"}
+
+		got, err := KeyByErr(in, strconv.Atoi)
+		assertErr(t, "index 2: strconv.Atoi", err)
+		assertEq(t, want, got)
+
+		got, err = KeyByCtx(context.Background(), in, func(_ context.Context, s string) (int, error) {
+			return strconv.Atoi(s)
+		})
+		assertErr(t, "index 2: strconv.Atoi", err)
+		assertEq(t, want, got)
+	})
+}
+
+func TestGroupBy(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []string
+		want map[int][]string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "empty",
+			in:   []string{},
+			want: map[int][]string{},
+		},
+		{
+			name: "groups",
+			in:   []string{"a", "bb", "c", "dd", "eee"},
+			want: map[int][]string{1: {"a", "c"}, 2: {"bb", "dd"}, 3: {"eee"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GroupBy(tt.in, func(s string) int { return len(s) })
+			assertEq(t, tt.want, got)
+
+			got, err := GroupByErr(tt.in, func(s string) (int, error) { return len(s), nil })
+			assertErr(t, "", err)
+			assertEq(t, tt.want, got)
+
+			got, err = GroupByCtx(context.Background(), tt.in, func(_ context.Context, s string) (int, error) { return len(s), nil })
+			assertErr(t, "", err)
+			assertEq(t, tt.want, got)
+		})
+	}
+
+	t.Run("err", func(t *testing.T) {
+		in := []string{"1", "2", "1", "err", "3"}
+		want := map[int][]string{1: {"1", "1"}, 2: {"2"}}
+
+		got, err := GroupByErr(in, strconv.Atoi)
+		assertErr(t, "index 3: strconv.Atoi", err)
+		assertEq(t, want, got)
+
+		got, err = GroupByCtx(context.Background(), in, func(_ context.Context, s string) (int, error) {
+			return strconv.Atoi(s)
+		})
+		assertErr(t, "index 3: strconv.Atoi", err)
+		assertEq(t, want, got)
+	})
+}
@@ -0,0 +1,120 @@
+package xslices
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// LatencyBucket is a bucket in a [LatencyHistogram], counting durations up to Max.
+type LatencyBucket struct {
+	// Max is the inclusive upper bound of durations in the bucket.
+	// The final overflow bucket has a Max of [math.MaxInt64].
+	Max   time.Duration
+	Count int
+}
+
+// LatencyHistogram is an [Observer] that records the duration of each call in buckets.
+// It is safe for concurrent use.
+type LatencyHistogram struct {
+	bounds []time.Duration
+
+	mu     sync.Mutex // protects below fields.
+	counts []int
+	total  int
+	sum    time.Duration
+}
+
+var _ Observer = (*LatencyHistogram)(nil)
+
+// NewLatencyHistogram creates a histogram with buckets for each of the specified upper bounds,
+// as well as an overflow bucket for longer durations.
+// It panics if bounds are not positive and strictly increasing.
+func NewLatencyHistogram(bounds ...time.Duration) *LatencyHistogram {
+	for i, b := range bounds {
+		if b <= 0 || (i > 0 && b <= bounds[i-1]) {
+			panic(fmt.Sprintf("xslices: invalid histogram bounds %v", bounds))
+		}
+	}
+
+	return &LatencyHistogram{
+		bounds: slices.Clone(bounds),
+		counts: make([]int, len(bounds)+1),
+	}
+}
+
+// OnStart implements [Observer].
+func (h *LatencyHistogram) OnStart(int) {}
+
+// OnDone implements [Observer].
+func (h *LatencyHistogram) OnDone(_ int, dur time.Duration, _ error) {
+	idx, _ := slices.BinarySearch(h.bounds, dur)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.counts[idx]++
+	h.total++
+	h.sum += dur
+}
+
+// Buckets returns the count of durations in each bucket, including the overflow bucket.
+func (h *LatencyHistogram) Buckets() []LatencyBucket {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	buckets := make([]LatencyBucket, len(h.counts))
+	for i, count := range h.counts {
+		buckets[i] = LatencyBucket{Max: h.bucketMax(i), Count: count}
+	}
+	return buckets
+}
+
+// Count returns the number of recorded durations.
+func (h *LatencyHistogram) Count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.total
+}
+
+// Mean returns the mean of the recorded durations, or zero if there are none.
+func (h *LatencyHistogram) Mean() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.total == 0 {
+		return 0
+	}
+	return h.sum / time.Duration(h.total)
+}
+
+// Quantile returns an upper bound for the q-th quantile of recorded durations, where q is in [0, 1].
+// The result is the Max of the bucket containing the quantile, or zero if there are no durations.
+func (h *LatencyHistogram) Quantile(q float64) time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.total == 0 {
+		return 0
+	}
+
+	rank := max(int(math.Ceil(q*float64(h.total))), 1)
+	var seen int
+	for i, count := range h.counts {
+		seen += count
+		if seen >= rank {
+			return h.bucketMax(i)
+		}
+	}
+	return h.bucketMax(len(h.counts) - 1)
+}
+
+func (h *LatencyHistogram) bucketMax(i int) time.Duration {
+	if i < len(h.bounds) {
+		return h.bounds[i]
+	}
+	return math.MaxInt64
+}
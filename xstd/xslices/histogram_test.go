@@ -0,0 +1,65 @@
+package xslices
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+const maxDuration = time.Duration(math.MaxInt64)
+
+func TestLatencyHistogram(t *testing.T) {
+	h := NewLatencyHistogram(10*time.Millisecond, 100*time.Millisecond, time.Second)
+	assertEq(t, 0, h.Count())
+	assertEq(t, time.Duration(0), h.Mean())
+	assertEq(t, time.Duration(0), h.Quantile(0.5))
+
+	durations := []time.Duration{
+		time.Millisecond,
+		10 * time.Millisecond, // bounds are inclusive.
+		50 * time.Millisecond,
+		60 * time.Millisecond,
+		70 * time.Millisecond,
+		500 * time.Millisecond,
+		10 * time.Second,
+		20 * time.Second,
+	}
+	for i, d := range durations {
+		h.OnStart(i)
+		h.OnDone(i, d, nil)
+	}
+
+	assertEq(t, []LatencyBucket{
+		{Max: 10 * time.Millisecond, Count: 2},
+		{Max: 100 * time.Millisecond, Count: 3},
+		{Max: time.Second, Count: 1},
+		{Max: maxDuration, Count: 2},
+	}, h.Buckets())
+	assertEq(t, 8, h.Count())
+	assertEq(t, 30691*time.Millisecond/8, h.Mean())
+
+	tests := []struct {
+		q    float64
+		want time.Duration
+	}{
+		{q: 0, want: 10 * time.Millisecond},
+		{q: 0.25, want: 10 * time.Millisecond},
+		{q: 0.5, want: 100 * time.Millisecond},
+		{q: 0.75, want: time.Second},
+		{q: 0.9, want: maxDuration},
+		{q: 1, want: maxDuration},
+	}
+	for _, tt := range tests {
+		assertEq(t, tt.want, h.Quantile(tt.q))
+	}
+}
+
+func TestNewLatencyHistogram_Invalid(t *testing.T) {
+	assertPanics(t, func() { NewLatencyHistogram(0) })
+	assertPanics(t, func() { NewLatencyHistogram(time.Second, time.Second) })
+	assertPanics(t, func() { NewLatencyHistogram(time.Second, time.Millisecond) })
+
+	h := NewLatencyHistogram()
+	h.OnDone(0, time.Hour, nil)
+	assertEq(t, []LatencyBucket{{Max: maxDuration, Count: 1}}, h.Buckets())
+}
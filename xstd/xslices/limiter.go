@@ -0,0 +1,120 @@
+package xslices
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket rate limiter, used to limit the rate of calls to a mapping function.
+// The bucket holds up to burst tokens, and is refilled at rate tokens per second.
+// Each call uses a single token, and waits for a token if the bucket is empty.
+//
+// A Limiter must be created using [NewLimiter]. It is safe for concurrent use,
+// and may be shared across multiple mapping calls to limit their combined rate.
+type Limiter struct {
+	// Clock is used to get the current time and wait for tokens. If nil, the system clock is used.
+	// It must not be modified after the first call to Wait.
+	Clock Clock
+
+	rate  float64 // tokens per second.
+	burst float64
+
+	mu      sync.Mutex // protects below fields.
+	tokens  float64    // negative when tokens are reserved by waiting callers.
+	last    time.Time  // time tokens was last updated.
+	started bool       // whether last is set, since a Clock may return the zero time.
+}
+
+// NewLimiter creates a limiter that allows rate events per second, with bursts of up to burst events.
+// The bucket starts full. It panics if rate is not positive, or burst is less than 1.
+func NewLimiter(rate float64, burst int) *Limiter {
+	if rate <= 0 {
+		panic(fmt.Sprintf("xslices: invalid limiter rate %v", rate))
+	}
+	if burst < 1 {
+		panic(fmt.Sprintf("xslices: invalid limiter burst %d", burst))
+	}
+
+	return &Limiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+// Wait blocks until a token is available, or ctx is done.
+// If ctx is done before a token is available, the context error is returned,
+// and the reserved token is returned to the bucket.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	clock := clockOrSystem(l.Clock)
+	delay := l.reserve(clock.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	if err := wait(ctx, clock, delay); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token, and returns the delay until the token is available.
+func (l *Limiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.started && now.After(l.last) {
+		l.tokens = min(l.tokens+now.Sub(l.last).Seconds()*l.rate, l.burst)
+	}
+	if !l.started || now.After(l.last) {
+		l.last = now
+		l.started = true
+	}
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token to the bucket.
+func (l *Limiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = min(l.tokens+1, l.burst)
+}
+
+// MapCtxRateLimited runs the context-aware mapping function to map a slice to a new slice,
+// waiting for a token from limiter before each call.
+// The mapping function may return an error which stops mapping.
+// If ctx is done while waiting for a token, mapping stops with the context error.
+// Errors are returned as an [*IndexError] with the partially mapped slice,
+// which contains the results for all elements before the failed index.
+func MapCtxRateLimited[X, Y any](ctx context.Context, xs []X, fn func(context.Context, X) (Y, error), limiter *Limiter) ([]Y, error) {
+	return MapCtx(ctx, xs, rateLimited(limiter, fn))
+}
+
+// rateLimited wraps fn to wait for a token from limiter before each call.
+// If limiter is nil, fn is returned unmodified.
+func rateLimited[X, Y any](limiter *Limiter, fn func(context.Context, X) (Y, error)) func(context.Context, X) (Y, error) {
+	if limiter == nil {
+		return fn
+	}
+
+	return func(ctx context.Context, x X) (Y, error) {
+		if err := limiter.Wait(ctx); err != nil {
+			var zero Y
+			return zero, err
+		}
+		return fn(ctx, x)
+	}
+}
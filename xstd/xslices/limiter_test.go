@@ -0,0 +1,209 @@
+package xslices
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestLimiter(rate float64, burst int) (*Limiter, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	l := NewLimiter(rate, burst)
+	l.Clock = clock
+	return l, clock
+}
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+	l, clock := newTestLimiter(10, 3)
+
+	// The bucket starts full, so the burst does not wait.
+	for range 3 {
+		assertErr(t, "", l.Wait(ctx))
+	}
+	assertEq(t, []time.Duration(nil), clock.waits)
+
+	// Once empty, calls wait for tokens at the configured rate.
+	assertErr(t, "", l.Wait(ctx))
+	assertErr(t, "", l.Wait(ctx))
+	assertEq(t, []time.Duration{100 * time.Millisecond, 100 * time.Millisecond}, clock.waits)
+
+	// Idle time refills the bucket, up to burst.
+	clock.now = clock.now.Add(time.Hour)
+	clock.waits = nil
+	for range 3 {
+		assertErr(t, "", l.Wait(ctx))
+	}
+	assertErr(t, "", l.Wait(ctx))
+	assertEq(t, []time.Duration{100 * time.Millisecond}, clock.waits)
+}
+
+func TestLimiter_PartialRefill(t *testing.T) {
+	ctx := context.Background()
+	l, clock := newTestLimiter(2, 1)
+
+	assertErr(t, "", l.Wait(ctx))
+	clock.now = clock.now.Add(250 * time.Millisecond)
+	assertErr(t, "", l.Wait(ctx))
+	assertEq(t, []time.Duration{250 * time.Millisecond}, clock.waits)
+}
+
+func TestLimiter_ZeroTimeClock(t *testing.T) {
+	ctx := context.Background()
+	l := NewLimiter(1, 1)
+	clock := &fakeClock{}
+	l.Clock = clock
+
+	// The first call is at the zero time, but time since then still refills the bucket.
+	assertErr(t, "", l.Wait(ctx))
+	clock.now = clock.now.Add(time.Second)
+	assertErr(t, "", l.Wait(ctx))
+	assertEq(t, []time.Duration(nil), clock.waits)
+}
+
+func TestLimiter_ContextDone(t *testing.T) {
+	l := NewLimiter(1, 1)
+	l.Clock = blockingClock{}
+
+	assertErr(t, "", l.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assertErr(t, context.DeadlineExceeded.Error(), l.Wait(ctx))
+
+	// A canceled context fails without reserving a token.
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	assertErr(t, context.Canceled.Error(), l.Wait(canceled))
+
+	// Canceled waits return their token, so the next caller waits for a single token.
+	clock := &fakeClock{}
+	l.Clock = clock
+	assertErr(t, "", l.Wait(context.Background()))
+	assertEq(t, []time.Duration{time.Second}, clock.waits)
+}
+
+func TestNewLimiter_Invalid(t *testing.T) {
+	assertPanics(t, func() { NewLimiter(0, 1) })
+	assertPanics(t, func() { NewLimiter(-1, 1) })
+	assertPanics(t, func() { NewLimiter(1, 0) })
+}
+
+func TestMapCtxRateLimited(t *testing.T) {
+	atoi := func(_ context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	}
+
+	tests := []struct {
+		name      string
+		in        []string
+		want      []int
+		wantErr   string
+		wantWaits []time.Duration
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name:      "waits after burst",
+			in:        []string{"1", "2", "3", "4"},
+			want:      []int{1, 2, 3, 4},
+			wantWaits: []time.Duration{500 * time.Millisecond, 500 * time.Millisecond},
+		},
+		{
+			name:      "err",
+			in:        []string{"1", "2", "err", "4"},
+			want:      []int{1, 2},
+			wantErr:   "index 2: strconv.Atoi",
+			wantWaits: []time.Duration{500 * time.Millisecond},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, clock := newTestLimiter(2, 2)
+			got, err := MapCtxRateLimited(context.Background(), tt.in, atoi, l)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+			assertEq(t, tt.wantWaits, clock.waits)
+		})
+	}
+
+	t.Run("context done while waiting", func(t *testing.T) {
+		l := NewLimiter(1, 1)
+		l.Clock = blockingClock{}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		got, err := MapCtxRateLimited(ctx, []string{"1", "2", "3"}, atoi, l)
+		assertErr(t, "index 1: "+context.DeadlineExceeded.Error(), err)
+		assertEq(t, []int{1}, got)
+	})
+}
+
+// frozenClock does not wait, and does not advance time, so tokens are never refilled.
+type frozenClock struct {
+	waits atomic.Int32
+}
+
+func (c *frozenClock) Now() time.Time {
+	return time.Time{}.Add(time.Hour)
+}
+
+func (c *frozenClock) After(time.Duration) <-chan time.Time {
+	c.waits.Add(1)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.Now()
+	return ch
+}
+
+func TestMapParallel_Limiter(t *testing.T) {
+	// Time does not advance, so the wait count doesn't depend on the order of concurrent waits.
+	clock := &frozenClock{}
+	l := NewLimiter(100, 5)
+	l.Clock = clock
+
+	var calls atomic.Int32
+	fn := func(_ context.Context, x int) (int, error) {
+		calls.Add(1)
+		return x * 2, nil
+	}
+
+	xs := make([]int, 20)
+	for i := range xs {
+		xs[i] = i
+	}
+
+	got, err := MapParallel(context.Background(), xs, fn, ParallelOptions{Concurrency: 4, Limiter: l})
+	assertErr(t, "", err)
+	for i, y := range got {
+		assertEq(t, i*2, y)
+	}
+	assertEq(t, int32(len(xs)), calls.Load())
+
+	// All calls after the burst wait for the limiter.
+	assertEq(t, int32(len(xs)-5), clock.waits.Load())
+}
+
+func TestMapBatchedParallel_Limiter(t *testing.T) {
+	clock := &frozenClock{}
+	l := NewLimiter(100, 1)
+	l.Clock = clock
+
+	double := func(_ context.Context, batch []int) ([]int, error) {
+		return Map(batch, func(x int) int { return x * 2 }), nil
+	}
+
+	got, err := MapBatchedParallel(context.Background(), []int{1, 2, 3, 4, 5}, 2, double, ParallelOptions{Limiter: l})
+	assertErr(t, "", err)
+	assertEq(t, []int{2, 4, 6, 8, 10}, got)
+
+	// The limiter is used once per batch.
+	assertEq(t, int32(2), clock.waits.Load())
+}
@@ -2,6 +2,7 @@ package xslices
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
@@ -19,8 +20,25 @@ func Map[X, Y any](xs []X, fn func(X) Y) []Y {
 	return ys
 }
 
+// IndexError is the error returned when mapping the element at Index fails.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
 // MapErr runs the mapping function to map a slice to a new slice.
-// The mapping function may return an error which stops and returns the partially mapped slice.
+// The mapping function may return an error which stops mapping.
+// The error is returned as an [*IndexError] with the partially mapped slice,
+// which contains the results for all elements before the failed index.
 func MapErr[X, Y any](xs []X, fn func(X) (Y, error)) ([]Y, error) {
 	if xs == nil {
 		return nil, nil
@@ -31,7 +49,7 @@ func MapErr[X, Y any](xs []X, fn func(X) (Y, error)) ([]Y, error) {
 		var err error
 		ys[i], err = fn(xs[i])
 		if err != nil {
-			return nil, fmt.Errorf("index %d: %w", i, err)
+			return ys[:i], &IndexError{Index: i, Err: err}
 		}
 	}
 
@@ -39,7 +57,9 @@ func MapErr[X, Y any](xs []X, fn func(X) (Y, error)) ([]Y, error) {
 }
 
 // MapCtx runs the context-aware mapping function to map a slice to a new slice.
-// The mapping function may return an error which stops and returns the partially mapped slice.
+// The mapping function may return an error which stops mapping.
+// The error is returned as an [*IndexError] with the partially mapped slice,
+// which contains the results for all elements before the failed index.
 // MapCtx does not explicitly check for context errors, the mapping function is expected to respect and propagate context errors.
 func MapCtx[X, Y any](ctx context.Context, xs []X, fn func(context.Context, X) (Y, error)) ([]Y, error) {
 	if xs == nil {
@@ -51,8 +71,56 @@ func MapCtx[X, Y any](ctx context.Context, xs []X, fn func(context.Context, X) (
 		var err error
 		ys[i], err = fn(ctx, xs[i])
 		if err != nil {
-			return nil, fmt.Errorf("index %d: %w", i, err)
+			return ys[:i], &IndexError{Index: i, Err: err}
 		}
 	}
 	return ys, nil
 }
+
+// AllOptions configures MapErrAll and MapCtxAll.
+type AllOptions struct {
+	// MaxErrors stops mapping once the specified number of errors have occurred.
+	// If zero or negative, all elements are mapped regardless of errors.
+	MaxErrors int
+}
+
+// MapErrAll runs the mapping function to map a slice to a new slice.
+// Unlike MapErr, errors do not stop mapping, and all successfully mapped results are returned.
+// The returned error joins an [*IndexError] for every failed index, see [errors.Join],
+// and the results for failed indexes are zero values.
+//
+// If opts.MaxErrors is reached, mapping stops, and the remaining results are zero values.
+func MapErrAll[X, Y any](xs []X, fn func(X) (Y, error), opts AllOptions) ([]Y, error) {
+	return MapCtxAll(context.Background(), xs, func(_ context.Context, x X) (Y, error) {
+		return fn(x)
+	}, opts)
+}
+
+// MapCtxAll runs the context-aware mapping function to map a slice to a new slice.
+// Unlike MapCtx, errors do not stop mapping, and all successfully mapped results are returned.
+// The returned error joins an [*IndexError] for every failed index, see [errors.Join],
+// and the results for failed indexes are zero values.
+//
+// If opts.MaxErrors is reached, mapping stops, and the remaining results are zero values.
+// MapCtxAll does not explicitly check for context errors, the mapping function is expected to respect and propagate context errors.
+func MapCtxAll[X, Y any](ctx context.Context, xs []X, fn func(context.Context, X) (Y, error), opts AllOptions) ([]Y, error) {
+	if xs == nil {
+		return nil, nil
+	}
+
+	var errs []error
+	ys := make([]Y, len(xs))
+	for i := range xs {
+		y, err := fn(ctx, xs[i])
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			if opts.MaxErrors > 0 && len(errs) >= opts.MaxErrors {
+				break
+			}
+			continue
+		}
+		ys[i] = y
+	}
+
+	return ys, errors.Join(errs...)
+}
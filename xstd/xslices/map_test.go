@@ -2,6 +2,7 @@ package xslices
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"strconv"
 	"strings"
@@ -74,6 +75,7 @@ func TestMapErr(t *testing.T) {
 		{
 			name:    "err",
 			in:      []string{"1", "2", "err", "3"},
+			want:    []int{1, 2},
 			wantErr: "index 2: strconv.Atoi",
 		},
 	}
@@ -145,6 +147,7 @@ func TestMapCtx(t *testing.T) {
 			fn:      arr(respectCtx),
 			ctx:     arr(canceled),
 			in:      []string{"1", "2", "3"},
+			want:    []int{},
 			wantErr: "index 0: " + context.Canceled.Error(),
 		},
 		{
@@ -152,6 +155,7 @@ func TestMapCtx(t *testing.T) {
 			fn:      arr(ignoreCtx),
 			ctx:     arr(context.Background(), canceled),
 			in:      []string{"1", "err", "3"},
+			want:    []int{1},
 			wantErr: "index 1: strconv.Atoi",
 		},
 	}
@@ -169,6 +173,181 @@ func TestMapCtx(t *testing.T) {
 	}
 }
 
+func TestMapErrAll(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        []string
+		maxErrors int
+		want      []int
+		wantErrs  []string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "empty",
+			in:   []string{},
+			want: []int{},
+		},
+		{
+			name: "no errors",
+			in:   []string{"1", "2", "3"},
+			want: []int{1, 2, 3},
+		},
+		{
+			name:     "single error",
+			in:       []string{"1", "err", "3"},
+			want:     []int{1, 0, 3},
+			wantErrs: []string{"index 1: strconv.Atoi"},
+		},
+		{
+			name:     "multiple errors",
+			in:       []string{"e1", "2", "e3", "4", "e5"},
+			want:     []int{0, 2, 0, 4, 0},
+			wantErrs: []string{"index 0: strconv.Atoi", "index 2: strconv.Atoi", "index 4: strconv.Atoi"},
+		},
+		{
+			name:      "max errors not reached",
+			in:        []string{"e1", "2", "e3", "4"},
+			maxErrors: 3,
+			want:      []int{0, 2, 0, 4},
+			wantErrs:  []string{"index 0: strconv.Atoi", "index 2: strconv.Atoi"},
+		},
+		{
+			name:      "max errors reached",
+			in:        []string{"e1", "2", "e3", "4", "e5"},
+			maxErrors: 2,
+			want:      []int{0, 2, 0, 0, 0},
+			wantErrs:  []string{"index 0: strconv.Atoi", "index 2: strconv.Atoi"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(t *testing.T, got []int, err error) {
+				t.Helper()
+
+				assertEq(t, tt.want, got)
+				if len(tt.wantErrs) == 0 {
+					assertErr(t, "", err)
+					return
+				}
+
+				joined, ok := err.(interface{ Unwrap() []error })
+				if !ok {
+					t.Fatalf("expected joined error, got %T", err)
+				}
+				errs := joined.Unwrap()
+				assertEq(t, len(tt.wantErrs), len(errs))
+				for i, wantErr := range tt.wantErrs {
+					assertErr(t, wantErr, errs[i])
+				}
+			}
+
+			t.Run("MapErrAll", func(t *testing.T) {
+				got, err := MapErrAll(tt.in, strconv.Atoi, AllOptions{MaxErrors: tt.maxErrors})
+				check(t, got, err)
+			})
+
+			t.Run("MapCtxAll", func(t *testing.T) {
+				got, err := MapCtxAll(context.Background(), tt.in, func(_ context.Context, s string) (int, error) {
+					return strconv.Atoi(s)
+				}, AllOptions{MaxErrors: tt.maxErrors})
+				check(t, got, err)
+			})
+		})
+	}
+}
+
+func TestMapCtxAll_Context(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	got, err := MapCtxAll(ctx, []int{1, 2}, func(ctx context.Context, x int) (int, error) {
+		return x, ctx.Err()
+	}, AllOptions{})
+	assertEq(t, []int{0, 0}, got)
+	assertErr(t, "index 0: context canceled\nindex 1: context canceled", err)
+}
+
+func TestMapErrAll_FailedResultsZero(t *testing.T) {
+	got, err := MapErrAll([]int{1, 2, 3}, func(x int) (int, error) {
+		if x == 2 {
+			return x * 10, errors.New("partial result")
+		}
+		return x * 10, nil
+	}, AllOptions{})
+	assertEq(t, []int{10, 0, 30}, got)
+	assertErr(t, "index 1: partial result", err)
+}
+
+func TestIndexError(t *testing.T) {
+	atoiCtx := func(_ context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	}
+	in := []string{"1", "2", "err", "4"}
+
+	tests := []struct {
+		name string
+		run  func() error
+	}{
+		{
+			name: "MapErr",
+			run: func() error {
+				_, err := MapErr(in, strconv.Atoi)
+				return err
+			},
+		},
+		{
+			name: "MapCtx",
+			run: func() error {
+				_, err := MapCtx(context.Background(), in, atoiCtx)
+				return err
+			},
+		},
+		{
+			name: "MapErrAll",
+			run: func() error {
+				_, err := MapErrAll(in, strconv.Atoi, AllOptions{})
+				return err
+			},
+		},
+		{
+			name: "MapCtxAll",
+			run: func() error {
+				_, err := MapCtxAll(context.Background(), in, atoiCtx, AllOptions{})
+				return err
+			},
+		},
+		{
+			name: "MapParallel",
+			run: func() error {
+				_, err := MapParallel(context.Background(), in, atoiCtx, ParallelOptions{})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.run()
+
+			var indexErr *IndexError
+			if !errors.As(err, &indexErr) {
+				t.Fatalf("expected IndexError, got %T: %v", err, err)
+			}
+			assertEq(t, 2, indexErr.Index)
+			assertEq(t, "err", in[indexErr.Index])
+
+			var numErr *strconv.NumError
+			assertEq(t, true, errors.As(err, &numErr))
+			assertEq(t, strconv.ErrSyntax, numErr.Err)
+		})
+	}
+}
+
 func BenchmarkMap(b *testing.B) {
 	xs := make([]int, 10000)
 	for b.Loop() {
@@ -0,0 +1,82 @@
+package xslices
+
+import (
+	"context"
+	"time"
+)
+
+// Observer is notified as each element is mapped, to monitor long-running mapping.
+type Observer interface {
+	// OnStart is called before the mapping function is called for the element at index i.
+	OnStart(i int)
+
+	// OnDone is called after the mapping function returns for the element at index i,
+	// with the duration of the call and the returned error.
+	OnDone(i int, dur time.Duration, err error)
+}
+
+// Observers returns an observer that notifies each of observers in order.
+func Observers(observers ...Observer) Observer {
+	return multiObserver(observers)
+}
+
+type multiObserver []Observer
+
+func (m multiObserver) OnStart(i int) {
+	for _, o := range m {
+		o.OnStart(i)
+	}
+}
+
+func (m multiObserver) OnDone(i int, dur time.Duration, err error) {
+	for _, o := range m {
+		o.OnDone(i, dur, err)
+	}
+}
+
+// MapOptions configures MapCtxOpts.
+type MapOptions struct {
+	// Observer, if set, is notified as each element is mapped.
+	Observer Observer
+
+	// Clock is used to measure durations reported to Observer. If nil, the system clock is used.
+	Clock Clock
+}
+
+// MapCtxOpts runs the context-aware mapping function to map a slice to a new slice,
+// notifying opts.Observer as each element is mapped.
+// The mapping function may return an error which stops mapping.
+// The error is returned as an [*IndexError] with the partially mapped slice,
+// which contains the results for all elements before the failed index.
+// MapCtxOpts does not explicitly check for context errors, the mapping function is expected to respect and propagate context errors.
+func MapCtxOpts[X, Y any](ctx context.Context, xs []X, fn func(context.Context, X) (Y, error), opts MapOptions) ([]Y, error) {
+	if xs == nil {
+		return nil, nil
+	}
+
+	clock := clockOrSystem(opts.Clock)
+	ys := make([]Y, len(xs))
+	for i := range xs {
+		var err error
+		ys[i], err = observe(opts.Observer, clock, i, func() (Y, error) {
+			return fn(ctx, xs[i])
+		})
+		if err != nil {
+			return ys[:i], &IndexError{Index: i, Err: err}
+		}
+	}
+	return ys, nil
+}
+
+// observe calls fn, notifying the observer (if any) for the element at index i.
+func observe[Y any](o Observer, clock Clock, i int, fn func() (Y, error)) (Y, error) {
+	if o == nil {
+		return fn()
+	}
+
+	o.OnStart(i)
+	start := clock.Now()
+	y, err := fn()
+	o.OnDone(i, clock.Now().Sub(start), err)
+	return y, err
+}
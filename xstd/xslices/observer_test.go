@@ -0,0 +1,133 @@
+package xslices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingObserver records calls as strings.
+type recordingObserver struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (o *recordingObserver) OnStart(i int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.calls = append(o.calls, fmt.Sprintf("start %d", i))
+}
+
+func (o *recordingObserver) OnDone(i int, dur time.Duration, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.calls = append(o.calls, fmt.Sprintf("done %d %v %v", i, dur, err))
+}
+
+func TestMapCtxOpts(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        []string
+		want      []int
+		wantErr   string
+		wantCalls []string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "multiple elements",
+			in:   []string{"1", "2"},
+			want: []int{1, 2},
+			wantCalls: []string{
+				"start 0", "done 0 1s <nil>",
+				"start 1", "done 1 2s <nil>",
+			},
+		},
+		{
+			name:    "err",
+			in:      []string{"1", "err", "3"},
+			want:    []int{1},
+			wantErr: "index 1: strconv.Atoi",
+			wantCalls: []string{
+				"start 0", "done 0 1s <nil>",
+				"start 1", `done 1 2s strconv.Atoi: parsing "err": invalid syntax`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &fakeClock{}
+			var calls int
+			slowAtoi := func(_ context.Context, s string) (int, error) {
+				// Each call takes 1s longer than the previous call.
+				calls++
+				clock.After(time.Duration(calls) * time.Second)
+				return strconv.Atoi(s)
+			}
+
+			obs := &recordingObserver{}
+			got, err := MapCtxOpts(context.Background(), tt.in, slowAtoi, MapOptions{Observer: obs, Clock: clock})
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+			assertEq(t, tt.wantCalls, obs.calls)
+		})
+	}
+
+	t.Run("no observer", func(t *testing.T) {
+		got, err := MapCtxOpts(context.Background(), []string{"1", "2"}, func(_ context.Context, s string) (int, error) {
+			return strconv.Atoi(s)
+		}, MapOptions{})
+		assertErr(t, "", err)
+		assertEq(t, []int{1, 2}, got)
+	})
+}
+
+func TestObservers(t *testing.T) {
+	obs1, obs2 := &recordingObserver{}, &recordingObserver{}
+	obs := Observers(obs1, obs2)
+
+	errFailed := errors.New("failed")
+	obs.OnStart(1)
+	obs.OnDone(1, time.Second, errFailed)
+
+	want := []string{"start 1", "done 1 1s failed"}
+	assertEq(t, want, obs1.calls)
+	assertEq(t, want, obs2.calls)
+}
+
+func TestMapParallel_Observer(t *testing.T) {
+	xs := make([]int, 50)
+	for i := range xs {
+		xs[i] = i
+	}
+
+	hist := NewLatencyHistogram(time.Millisecond)
+	eta := NewETA(len(xs))
+	obs := &recordingObserver{}
+
+	got, err := MapParallel(context.Background(), xs, func(_ context.Context, x int) (int, error) {
+		return x * 2, nil
+	}, ParallelOptions{
+		Concurrency: 4,
+		Observer:    Observers(hist, eta, obs),
+		Clock:       &frozenClock{},
+	})
+	assertErr(t, "", err)
+	for i, y := range got {
+		assertEq(t, i*2, y)
+	}
+
+	assertEq(t, []LatencyBucket{{Max: time.Millisecond, Count: 50}, {Max: maxDuration, Count: 0}}, hist.Buckets())
+	assertEq(t, 50, eta.Progress().Done)
+	assertEq(t, 100, len(obs.calls))
+}
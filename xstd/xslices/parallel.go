@@ -0,0 +1,151 @@
+package xslices
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelOptions configures the concurrency of parallel mapping functions.
+type ParallelOptions struct {
+	// Concurrency is the maximum number of concurrent calls to the mapping function.
+	// If zero or negative, GOMAXPROCS is used.
+	Concurrency int
+
+	// Limiter, if set, limits the rate of calls to the mapping function across all goroutines.
+	// The time spent waiting for the limiter counts towards the concurrency limit.
+	Limiter *Limiter
+
+	// Observer, if set, is notified as each element is mapped.
+	// It's called concurrently, so it must be safe for concurrent use.
+	// Reported durations do not include time waiting for Limiter.
+	Observer Observer
+
+	// Clock is used to measure durations reported to Observer. If nil, the system clock is used.
+	Clock Clock
+}
+
+func (o ParallelOptions) concurrency(n int) int {
+	c := o.Concurrency
+	if c <= 0 {
+		c = runtime.GOMAXPROCS(0)
+	}
+	return max(min(c, n), 1)
+}
+
+// MapParallel runs the context-aware mapping function concurrently to map a slice to a new slice.
+// The results are in the same order as xs.
+//
+// The first error stops new calls from starting, cancels the context passed to in-flight calls,
+// and is returned as an [*IndexError] after in-flight calls complete.
+// Since elements are mapped out of order, no partial results are returned on error.
+// If the mapping function panics, the panic is propagated to the caller,
+// including the stack of the panicking goroutine.
+func MapParallel[X, Y any](ctx context.Context, xs []X, fn func(context.Context, X) (Y, error), opts ParallelOptions) ([]Y, error) {
+	if xs == nil {
+		return nil, nil
+	}
+
+	clock := clockOrSystem(opts.Clock)
+	ys := make([]Y, len(xs))
+	err := runParallel(ctx, len(xs), opts, func(ctx context.Context, i int) error {
+		if opts.Limiter != nil {
+			if err := opts.Limiter.Wait(ctx); err != nil {
+				return &IndexError{Index: i, Err: err}
+			}
+		}
+
+		var err error
+		ys[i], err = observe(opts.Observer, clock, i, func() (Y, error) {
+			return fn(ctx, xs[i])
+		})
+		if err != nil {
+			return &IndexError{Index: i, Err: err}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ys, nil
+}
+
+// runParallel runs fn for indexes [0, n) using a bounded number of goroutines.
+// It returns the first error, and propagates the first panic.
+func runParallel(ctx context.Context, n int, opts ParallelOptions, fn func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		next    atomic.Int64
+		stopped atomic.Bool
+
+		mu          sync.Mutex // protects below fields.
+		firstErr    error
+		firstPanic  *panicError
+		recordFirst = func(err error, p *panicError) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if firstErr == nil && firstPanic == nil {
+				firstErr, firstPanic = err, p
+			}
+			stopped.Store(true)
+			cancel()
+		}
+	)
+
+	run := func(i int) {
+		defer func() {
+			if r := recover(); r != nil {
+				recordFirst(nil, &panicError{index: i, value: r, stack: debug.Stack()})
+			}
+		}()
+
+		if err := fn(ctx, i); err != nil {
+			recordFirst(err, nil)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for range opts.concurrency(n) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for !stopped.Load() {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				run(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstPanic != nil {
+		panic(firstPanic)
+	}
+	return firstErr
+}
+
+// panicError is used to propagate a panic from a worker goroutine to the caller.
+type panicError struct {
+	index int
+	value any
+	stack []byte
+}
+
+func (p *panicError) Error() string {
+	return fmt.Sprintf("panic in index %d: %v\n\ngoroutine stack:\n%s", p.index, p.value, p.stack)
+}
+
+// Unwrap returns the panic value if it's an error.
+func (p *panicError) Unwrap() error {
+	err, _ := p.value.(error)
+	return err
+}
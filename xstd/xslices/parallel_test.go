@@ -0,0 +1,186 @@
+package xslices
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMapParallel(t *testing.T) {
+	atoi := func(_ context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	}
+
+	tests := []struct {
+		name    string
+		in      []string
+		want    []int
+		wantErr string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "empty",
+			in:   []string{},
+			want: []int{},
+		},
+		{
+			name: "single element",
+			in:   []string{"1"},
+			want: []int{1},
+		},
+		{
+			name: "multiple elements",
+			in:   []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+			want: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		},
+		{
+			name:    "err",
+			in:      []string{"1", "2", "err", "3"},
+			wantErr: "index 2: strconv.Atoi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, concurrency := range []int{0, 1, 2, 100} {
+				got, err := MapParallel(context.Background(), tt.in, atoi, ParallelOptions{Concurrency: concurrency})
+				assertErr(t, tt.wantErr, err)
+				assertEq(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMapParallel_Concurrency(t *testing.T) {
+	const limit = 3
+
+	var inFlight, maxInFlight atomic.Int32
+	fn := func(_ context.Context, x int) (int, error) {
+		cur := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			prev := maxInFlight.Load()
+			if cur <= prev || maxInFlight.CompareAndSwap(prev, cur) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+		return x * 2, nil
+	}
+
+	xs := make([]int, 50)
+	for i := range xs {
+		xs[i] = i
+	}
+
+	got, err := MapParallel(context.Background(), xs, fn, ParallelOptions{Concurrency: limit})
+	assertErr(t, "", err)
+	for i, y := range got {
+		assertEq(t, i*2, y)
+	}
+
+	if n := maxInFlight.Load(); n > limit {
+		t.Fatalf("max concurrent calls %v exceeds limit %v", n, limit)
+	}
+}
+
+func TestMapParallel_ErrorCancels(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	var calls atomic.Int32
+	fn := func(ctx context.Context, x int) (int, error) {
+		calls.Add(1)
+		if x == 0 {
+			return 0, errFailed
+		}
+
+		// Block until canceled by the failure.
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+
+	xs := make([]int, 100)
+	for i := range xs {
+		xs[i] = i
+	}
+
+	got, err := MapParallel(context.Background(), xs, fn, ParallelOptions{Concurrency: 4})
+	assertErr(t, "index 0: failed", err)
+	assertEq(t, true, errors.Is(err, errFailed))
+	assertEq(t, []int(nil), got)
+
+	if n := calls.Load(); n >= int32(len(xs)) {
+		t.Fatalf("expected remaining calls to be skipped, got %v calls", n)
+	}
+}
+
+func TestMapParallel_ParentContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	respectCtx := func(ctx context.Context, x int) (int, error) {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		return x, nil
+	}
+
+	_, err := MapParallel(ctx, []int{1, 2, 3}, respectCtx, ParallelOptions{Concurrency: 1})
+	assertErr(t, "index 0: "+context.Canceled.Error(), err)
+}
+
+func TestMapParallel_Panic(t *testing.T) {
+	errPanic := errors.New("panic error")
+	fn := func(_ context.Context, x int) (int, error) {
+		if x == 3 {
+			panicInWorker(errPanic)
+		}
+		return x, nil
+	}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected panic")
+		}
+
+		err, ok := r.(error)
+		if !ok {
+			t.Fatalf("expected panic value to be an error, got %T", r)
+		}
+		assertEq(t, true, errors.Is(err, errPanic))
+
+		msg := err.Error()
+		for _, want := range []string{"panic in index 2: panic error", "panicInWorker"} {
+			if !strings.Contains(msg, want) {
+				t.Fatalf("panic message missing %q:\n%v", want, msg)
+			}
+		}
+	}()
+
+	MapParallel(context.Background(), []int{1, 2, 3, 4, 5}, fn, ParallelOptions{Concurrency: 2})
+}
+
+func panicInWorker(v any) {
+	panic(v)
+}
+
+func BenchmarkMapParallel(b *testing.B) {
+	xs := make([]int, 10000)
+	fn := func(_ context.Context, x int) (int, error) {
+		return x, nil
+	}
+	for b.Loop() {
+		MapParallel(context.Background(), xs, fn, ParallelOptions{})
+	}
+}
@@ -0,0 +1,162 @@
+package xslices
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Progress is a snapshot of the progress of mapping.
+type Progress struct {
+	Total  int
+	Done   int
+	Failed int
+
+	// Elapsed is the time since the first element started.
+	Elapsed time.Duration
+
+	// Remaining is the estimated time until all elements are done,
+	// based on the average rate of completion so far.
+	// It is zero if no elements are done.
+	Remaining time.Duration
+}
+
+// ETA is an [Observer] that tracks progress and estimates the time remaining.
+// It is safe for concurrent use.
+type ETA struct {
+	// Clock is used to get the current time. If nil, the system clock is used.
+	// It must not be modified after the first element starts.
+	Clock Clock
+
+	total int
+
+	mu     sync.Mutex // protects below fields.
+	start  time.Time
+	done   int
+	failed int
+}
+
+var _ Observer = (*ETA)(nil)
+
+// NewETA creates an ETA for mapping total elements.
+func NewETA(total int) *ETA {
+	return &ETA{total: total}
+}
+
+// OnStart implements [Observer].
+func (e *ETA) OnStart(int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.start.IsZero() {
+		e.start = clockOrSystem(e.Clock).Now()
+	}
+}
+
+// OnDone implements [Observer].
+func (e *ETA) OnDone(_ int, _ time.Duration, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.done++
+	if err != nil {
+		e.failed++
+	}
+}
+
+// Progress returns the current progress.
+func (e *ETA) Progress() Progress {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	p := Progress{
+		Total:  e.total,
+		Done:   e.done,
+		Failed: e.failed,
+	}
+	if e.start.IsZero() {
+		return p
+	}
+
+	p.Elapsed = clockOrSystem(e.Clock).Now().Sub(e.start)
+	if e.done > 0 && e.done < e.total {
+		perElement := p.Elapsed / time.Duration(e.done)
+		p.Remaining = perElement * time.Duration(e.total-e.done)
+	}
+	return p
+}
+
+// ProgressLogger is an [Observer] that periodically logs the progress tracked by an [ETA].
+// It is safe for concurrent use.
+type ProgressLogger struct {
+	logger   *slog.Logger
+	interval time.Duration
+	eta      *ETA
+
+	mu          sync.Mutex // protects below fields, and serializes logging.
+	lastLog     time.Time
+	loggedFinal bool
+}
+
+var _ Observer = (*ProgressLogger)(nil)
+
+// NewProgressLogger creates an observer that logs the progress of eta to logger
+// at most once per interval, and once all elements are done.
+// Calls are forwarded to eta, so eta should not be separately observed.
+func NewProgressLogger(logger *slog.Logger, interval time.Duration, eta *ETA) *ProgressLogger {
+	return &ProgressLogger{
+		logger:   logger,
+		interval: interval,
+		eta:      eta,
+	}
+}
+
+// OnStart implements [Observer].
+func (p *ProgressLogger) OnStart(i int) {
+	p.eta.OnStart(i)
+}
+
+// OnDone implements [Observer].
+func (p *ProgressLogger) OnDone(i int, dur time.Duration, err error) {
+	p.eta.OnDone(i, dur, err)
+
+	// Progress is read under the lock so concurrent calls log in order,
+	// and only one call logs the final line.
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	progress := p.eta.Progress()
+	if !p.shouldLog(progress) {
+		return
+	}
+
+	p.logger.LogAttrs(context.Background(), slog.LevelInfo, "mapping progress",
+		slog.Int("done", progress.Done),
+		slog.Int("total", progress.Total),
+		slog.Int("failed", progress.Failed),
+		slog.Duration("elapsed", progress.Elapsed),
+		slog.Duration("remaining", progress.Remaining),
+	)
+}
+
+// shouldLog must be called with p.mu held.
+func (p *ProgressLogger) shouldLog(progress Progress) bool {
+	if p.loggedFinal {
+		return false
+	}
+
+	now := clockOrSystem(p.eta.Clock).Now()
+	if p.lastLog.IsZero() {
+		// Measure the first interval from when mapping started.
+		p.lastLog = now.Add(-progress.Elapsed)
+	}
+
+	final := progress.Done >= progress.Total
+	if !final && now.Sub(p.lastLog) < p.interval {
+		return false
+	}
+	p.lastLog = now
+	p.loggedFinal = final
+	return true
+}
@@ -0,0 +1,94 @@
+package xslices
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestETA(total int) (*ETA, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	eta := NewETA(total)
+	eta.Clock = clock
+	return eta, clock
+}
+
+func TestETA(t *testing.T) {
+	errFailed := errors.New("failed")
+	eta, clock := newTestETA(4)
+	assertEq(t, Progress{Total: 4}, eta.Progress())
+
+	eta.OnStart(0)
+	clock.now = clock.now.Add(time.Second)
+	assertEq(t, Progress{Total: 4, Elapsed: time.Second}, eta.Progress())
+
+	eta.OnDone(0, time.Second, nil)
+	eta.OnStart(1)
+	clock.now = clock.now.Add(time.Second)
+	eta.OnDone(1, time.Second, errFailed)
+	assertEq(t, Progress{
+		Total:     4,
+		Done:      2,
+		Failed:    1,
+		Elapsed:   2 * time.Second,
+		Remaining: 2 * time.Second,
+	}, eta.Progress())
+
+	for i := 2; i < 4; i++ {
+		eta.OnStart(i)
+		clock.now = clock.now.Add(3 * time.Second)
+		eta.OnDone(i, 3*time.Second, nil)
+	}
+	assertEq(t, Progress{
+		Total:   4,
+		Done:    4,
+		Failed:  1,
+		Elapsed: 8 * time.Second,
+	}, eta.Progress())
+}
+
+func TestProgressLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	eta, clock := newTestETA(5)
+	p := NewProgressLogger(logger, 10*time.Second, eta)
+
+	// Each element takes 4s, so progress is logged every 3 elements, and once all are done.
+	for i := range 5 {
+		p.OnStart(i)
+		clock.now = clock.now.Add(4 * time.Second)
+		p.OnDone(i, 4*time.Second, nil)
+	}
+
+	want := "" +
+		"level=INFO msg=\"mapping progress\" done=3 total=5 failed=0 elapsed=12s remaining=8s\n" +
+		"level=INFO msg=\"mapping progress\" done=5 total=5 failed=0 elapsed=20s remaining=0s\n"
+	assertEq(t, want, buf.String())
+}
+
+func TestProgressLogger_FinalOnce(t *testing.T) {
+	var buf bytes.Buffer
+	eta, _ := newTestETA(2)
+	p := NewProgressLogger(slog.New(slog.NewTextHandler(&buf, nil)), time.Hour, eta)
+
+	// Calls after all elements are done, such as from concurrent or extra calls,
+	// do not log the final line again.
+	for i := range 3 {
+		p.OnStart(i)
+		p.OnDone(i, time.Second, nil)
+	}
+
+	assertEq(t, 1, strings.Count(buf.String(), "\n"))
+	assertEq(t, 1, strings.Count(buf.String(), "done=2 total=2"))
+}
@@ -0,0 +1,182 @@
+package xslices
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// The random functions use r as the source of randomness, so results are reproducible with a seeded source.
+// If r is nil, the top-level functions in [math/rand/v2] are used.
+
+func randIntN(r *rand.Rand, n int) int {
+	if r == nil {
+		return rand.IntN(n)
+	}
+	return r.IntN(n)
+}
+
+func randFloat64(r *rand.Rand) float64 {
+	if r == nil {
+		return rand.Float64()
+	}
+	return r.Float64()
+}
+
+// Shuffle randomly permutes the elements of xs in place, with all permutations equally likely.
+func Shuffle[X any](xs []X, r *rand.Rand) {
+	for i := len(xs) - 1; i > 0; i-- {
+		j := randIntN(r, i+1)
+		xs[i], xs[j] = xs[j], xs[i]
+	}
+}
+
+// Sample returns k elements chosen randomly from xs without replacement, in random order.
+// Each element (by position) is chosen at most once, so duplicate values in xs may be returned.
+// It takes O(k) time, and does not modify xs.
+// It panics if k is negative or greater than len(xs).
+func Sample[X any](xs []X, k int, r *rand.Rand) []X {
+	if k < 0 || k > len(xs) {
+		panic(fmt.Sprintf("xslices: invalid sample size %d for %d elements", k, len(xs)))
+	}
+
+	// Run the first k steps of a Fisher-Yates shuffle on the indexes of xs,
+	// tracking only the swapped indexes.
+	swapped := make(map[int]int, k)
+	index := func(i int) int {
+		if j, ok := swapped[i]; ok {
+			return j
+		}
+		return i
+	}
+
+	sample := make([]X, k)
+	for i := range k {
+		j := i + randIntN(r, len(xs)-i)
+		chosen := index(j)
+		swapped[j] = index(i)
+		sample[i] = xs[chosen]
+	}
+	return sample
+}
+
+// Weighted chooses elements randomly with probability proportional to their weights,
+// using the alias method, so each draw takes O(1) time after O(n) setup.
+// It is safe for concurrent use if the random source is.
+type Weighted[X any] struct {
+	items []X
+	prob  []float64 // probability of choosing items[i] rather than items[alias[i]].
+	alias []int
+}
+
+// NewWeighted creates a Weighted for items, where items[i] has weight weights[i].
+// Weights must be finite and non-negative, with a positive total.
+// Items with zero weight are never chosen.
+func NewWeighted[X any](items []X, weights []float64) (*Weighted[X], error) {
+	if len(items) != len(weights) {
+		return nil, fmt.Errorf("xslices: got %d items and %d weights", len(items), len(weights))
+	}
+
+	var total float64
+	for i, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, fmt.Errorf("xslices: invalid weight %v for index %d", w, i)
+		}
+		total += w
+	}
+	if total <= 0 || math.IsInf(total, 0) {
+		return nil, errors.New("xslices: weights must have a positive, finite total")
+	}
+
+	// Zero weights are excluded, so rounding errors can never choose them.
+	w := &Weighted[X]{}
+	var scaled []float64
+	for i, weight := range weights {
+		if weight > 0 {
+			w.items = append(w.items, items[i])
+			scaled = append(scaled, weight)
+		}
+	}
+
+	// Vose's alias method: scale weights so the average is 1,
+	// then pair each under-full column with an over-full column.
+	n := len(scaled)
+	w.prob = make([]float64, n)
+	w.alias = make([]int, n)
+
+	var small, large []int
+	for i := range scaled {
+		scaled[i] *= float64(n) / total
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small, large = small[:len(small)-1], large[:len(large)-1]
+
+		w.prob[s] = scaled[s]
+		w.alias[s] = l
+
+		scaled[l] += scaled[s] - 1
+		if scaled[l] < 1 {
+			small = append(small, l)
+		} else {
+			large = append(large, l)
+		}
+	}
+
+	// Remaining columns are full, other than rounding errors.
+	for _, i := range large {
+		w.prob[i] = 1
+	}
+	for _, i := range small {
+		w.prob[i] = 1
+	}
+	return w, nil
+}
+
+// Choice returns a randomly chosen item.
+func (w *Weighted[X]) Choice(r *rand.Rand) X {
+	i := randIntN(r, len(w.items))
+	if randFloat64(r) < w.prob[i] {
+		return w.items[i]
+	}
+	return w.items[w.alias[i]]
+}
+
+// Sample returns k randomly chosen items, with replacement.
+func (w *Weighted[X]) Sample(k int, r *rand.Rand) []X {
+	sample := make([]X, k)
+	for i := range sample {
+		sample[i] = w.Choice(r)
+	}
+	return sample
+}
+
+// WeightedChoice returns an element of xs chosen randomly with probability proportional to its weight.
+// Weights are validated the same as [NewWeighted].
+// To choose multiple times from the same weights, use [NewWeighted] to avoid repeated setup.
+func WeightedChoice[X any](xs []X, weights []float64, r *rand.Rand) (X, error) {
+	w, err := NewWeighted(xs, weights)
+	if err != nil {
+		var zero X
+		return zero, err
+	}
+	return w.Choice(r), nil
+}
+
+// WeightedSample returns k elements of xs chosen randomly with replacement,
+// with probability proportional to their weights.
+// Weights are validated the same as [NewWeighted].
+func WeightedSample[X any](xs []X, weights []float64, k int, r *rand.Rand) ([]X, error) {
+	w, err := NewWeighted(xs, weights)
+	if err != nil {
+		return nil, err
+	}
+	return w.Sample(k, r), nil
+}
@@ -0,0 +1,244 @@
+package xslices
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// Critical values of the chi-squared distribution at p = 0.001, by degrees of freedom.
+// Tests use seeded sources, so they are deterministic, but would fail on a biased implementation.
+var chiSquaredCritical = map[int]float64{
+	3:  16.27,
+	4:  18.47,
+	5:  20.52,
+	19: 43.82,
+}
+
+// assertUniform asserts that observed counts are consistent with the expected counts,
+// using Pearson's chi-squared test.
+func assertUniform[K comparable](t testing.TB, observed map[K]int, expected map[K]float64) {
+	t.Helper()
+
+	var stat float64
+	for k, want := range expected {
+		diff := float64(observed[k]) - want
+		stat += diff * diff / want
+	}
+	for k := range observed {
+		if _, ok := expected[k]; !ok {
+			t.Fatalf("unexpected observed key %v", k)
+		}
+	}
+
+	df := len(expected) - 1
+	critical, ok := chiSquaredCritical[df]
+	if !ok {
+		t.Fatalf("missing chi-squared critical value for %v degrees of freedom", df)
+	}
+	if stat > critical {
+		t.Fatalf("chi-squared statistic %.2f exceeds critical value %.2f, observed: %v", stat, critical, observed)
+	}
+}
+
+func newTestRand() *rand.Rand {
+	return rand.New(rand.NewPCG(1, 2))
+}
+
+func TestShuffle(t *testing.T) {
+	const trials = 60000
+	r := newTestRand()
+
+	observed := make(map[string]int)
+	for range trials {
+		xs := []int{1, 2, 3}
+		Shuffle(xs, r)
+		observed[fmt.Sprint(xs)]++
+	}
+
+	expected := make(map[string]float64)
+	for _, perm := range [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}} {
+		expected[fmt.Sprint(perm)] = trials / 6
+	}
+	assertUniform(t, observed, expected)
+
+	t.Run("empty and nil source", func(t *testing.T) {
+		Shuffle([]int(nil), nil)
+
+		xs := []int{1, 2, 3, 4, 5}
+		Shuffle(xs, nil)
+		slices.Sort(xs)
+		assertEq(t, []int{1, 2, 3, 4, 5}, xs)
+	})
+
+	t.Run("reproducible", func(t *testing.T) {
+		xs, ys := []int{1, 2, 3, 4, 5}, []int{1, 2, 3, 4, 5}
+		Shuffle(xs, newTestRand())
+		Shuffle(ys, newTestRand())
+		assertEq(t, xs, ys)
+	})
+}
+
+func TestSample(t *testing.T) {
+	const trials = 40000
+	r := newTestRand()
+
+	xs := []string{"a", "b", "c", "d", "e"}
+	observed := make(map[string]int)
+	for range trials {
+		sample := Sample(xs, 2, r)
+		if sample[0] == sample[1] {
+			t.Fatalf("sample contains duplicates: %v", sample)
+		}
+		observed[sample[0]+sample[1]]++
+	}
+
+	// All 20 ordered pairs are equally likely.
+	expected := make(map[string]float64)
+	for _, a := range xs {
+		for _, b := range xs {
+			if a != b {
+				expected[a+b] = trials / 20
+			}
+		}
+	}
+	assertUniform(t, observed, expected)
+
+	t.Run("all elements", func(t *testing.T) {
+		sample := Sample(xs, len(xs), r)
+		slices.Sort(sample)
+		assertEq(t, xs, sample)
+	})
+
+	t.Run("does not modify input", func(t *testing.T) {
+		in := []int{1, 2, 3, 4}
+		Sample(in, 3, nil)
+		assertEq(t, []int{1, 2, 3, 4}, in)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assertEq(t, []int{}, Sample([]int{1}, 0, r))
+		assertEq(t, []int{}, Sample([]int(nil), 0, r))
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		assertPanics(t, func() { Sample(xs, -1, r) })
+		assertPanics(t, func() { Sample(xs, len(xs)+1, r) })
+	})
+}
+
+func TestWeighted(t *testing.T) {
+	const trials = 100000
+	r := newTestRand()
+
+	items := []string{"a", "b", "c", "d", "zero"}
+	weights := []float64{1, 2, 3, 4, 0}
+	w, err := NewWeighted(items, weights)
+	assertErr(t, "", err)
+
+	expected := map[string]float64{
+		"a": trials * 0.1,
+		"b": trials * 0.2,
+		"c": trials * 0.3,
+		"d": trials * 0.4,
+	}
+
+	observed := make(map[string]int)
+	for range trials {
+		observed[w.Choice(r)]++
+	}
+	assertUniform(t, observed, expected)
+
+	observed = make(map[string]int)
+	for _, item := range w.Sample(trials, r) {
+		observed[item]++
+	}
+	assertUniform(t, observed, expected)
+
+	t.Run("single item", func(t *testing.T) {
+		w, err := NewWeighted([]int{7}, []float64{0.5})
+		assertErr(t, "", err)
+		for range 10 {
+			assertEq(t, 7, w.Choice(r))
+		}
+	})
+}
+
+func TestNewWeighted_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []float64
+		wantErr string
+	}{
+		{
+			name:    "length mismatch",
+			weights: []float64{1},
+			wantErr: "got 2 items and 1 weights",
+		},
+		{
+			name:    "negative",
+			weights: []float64{1, -1},
+			wantErr: "invalid weight -1 for index 1",
+		},
+		{
+			name:    "zero total",
+			weights: []float64{0, 0},
+			wantErr: "positive, finite total",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWeighted([]string{"a", "b"}, tt.weights)
+			assertErr(t, tt.wantErr, err)
+
+			_, err = WeightedChoice([]string{"a", "b"}, tt.weights, nil)
+			assertErr(t, tt.wantErr, err)
+
+			_, err = WeightedSample([]string{"a", "b"}, tt.weights, 1, nil)
+			assertErr(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestWeightedChoice(t *testing.T) {
+	const trials = 20000
+	r := newTestRand()
+
+	observed := make(map[int]int)
+	for range trials {
+		x, err := WeightedChoice([]int{1, 2, 3, 4, 5}, []float64{1, 1, 1, 1, 4}, r)
+		assertErr(t, "", err)
+		observed[x]++
+	}
+	assertUniform(t, observed, map[int]float64{
+		1: trials / 8,
+		2: trials / 8,
+		3: trials / 8,
+		4: trials / 8,
+		5: trials / 2,
+	})
+
+	sample, err := WeightedSample([]int{1, 2}, []float64{0, 1}, 5, r)
+	assertErr(t, "", err)
+	assertEq(t, []int{2, 2, 2, 2, 2}, sample)
+}
+
+func BenchmarkWeightedChoice(b *testing.B) {
+	items := make([]int, 1000)
+	weights := make([]float64, len(items))
+	for i := range items {
+		items[i] = i
+		weights[i] = float64(i%10 + 1)
+	}
+	w, err := NewWeighted(items, weights)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	r := newTestRand()
+	for b.Loop() {
+		w.Choice(r)
+	}
+}
@@ -0,0 +1,82 @@
+package xslices
+
+import "context"
+
+// Fold combines the elements of a slice into a single value,
+// by calling fn with the accumulated value and each element in order, starting with init.
+func Fold[X, Y any](xs []X, init Y, fn func(Y, X) Y) Y {
+	acc := init
+	for i := range xs {
+		acc = fn(acc, xs[i])
+	}
+	return acc
+}
+
+// FoldErr combines the elements of a slice into a single value,
+// by calling fn with the accumulated value and each element in order, starting with init.
+// The fold function may return an error which stops folding.
+// The error is returned as an [*IndexError] with the value accumulated before the failed index.
+func FoldErr[X, Y any](xs []X, init Y, fn func(Y, X) (Y, error)) (Y, error) {
+	return FoldCtx(context.Background(), xs, init, func(_ context.Context, acc Y, x X) (Y, error) {
+		return fn(acc, x)
+	})
+}
+
+// FoldCtx combines the elements of a slice into a single value,
+// by calling the context-aware fn with the accumulated value and each element in order, starting with init.
+// The fold function may return an error which stops folding.
+// The error is returned as an [*IndexError] with the value accumulated before the failed index.
+// FoldCtx does not explicitly check for context errors, the fold function is expected to respect and propagate context errors.
+func FoldCtx[X, Y any](ctx context.Context, xs []X, init Y, fn func(context.Context, Y, X) (Y, error)) (Y, error) {
+	acc := init
+	for i := range xs {
+		next, err := fn(ctx, acc, xs[i])
+		if err != nil {
+			return acc, &IndexError{Index: i, Err: err}
+		}
+		acc = next
+	}
+	return acc, nil
+}
+
+// Reduce combines the elements of a slice into a single value,
+// by calling fn with the accumulated value and each element in order, starting with the first element.
+// If the slice is empty, the zero value is returned.
+func Reduce[X any](xs []X, fn func(X, X) X) X {
+	if len(xs) == 0 {
+		var zero X
+		return zero
+	}
+	return Fold(xs[1:], xs[0], fn)
+}
+
+// ReduceErr combines the elements of a slice into a single value,
+// by calling fn with the accumulated value and each element in order, starting with the first element.
+// If the slice is empty, the zero value is returned.
+// The reduce function may return an error which stops reducing.
+// The error is returned as an [*IndexError] with the value accumulated before the failed index.
+func ReduceErr[X any](xs []X, fn func(X, X) (X, error)) (X, error) {
+	return ReduceCtx(context.Background(), xs, func(_ context.Context, acc, x X) (X, error) {
+		return fn(acc, x)
+	})
+}
+
+// ReduceCtx combines the elements of a slice into a single value,
+// by calling the context-aware fn with the accumulated value and each element in order, starting with the first element.
+// If the slice is empty, the zero value is returned.
+// The reduce function may return an error which stops reducing.
+// The error is returned as an [*IndexError] with the value accumulated before the failed index.
+// ReduceCtx does not explicitly check for context errors, the reduce function is expected to respect and propagate context errors.
+func ReduceCtx[X any](ctx context.Context, xs []X, fn func(context.Context, X, X) (X, error)) (X, error) {
+	if len(xs) == 0 {
+		var zero X
+		return zero, nil
+	}
+
+	acc, err := FoldCtx(ctx, xs[1:], xs[0], fn)
+	if indexErr, ok := err.(*IndexError); ok {
+		// Report the index relative to xs rather than xs[1:].
+		indexErr.Index++
+	}
+	return acc, err
+}
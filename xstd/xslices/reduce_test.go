@@ -0,0 +1,132 @@
+package xslices
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []string
+		want    int
+		wantErr string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: 100,
+		},
+		{
+			name: "multiple elements",
+			in:   []string{"1", "2", "3"},
+			want: 106,
+		},
+		{
+			name:    "err",
+			in:      []string{"1", "2", "err", "3"},
+			want:    103,
+			wantErr: "index 2: strconv.Atoi",
+		},
+	}
+
+	sumErr := func(acc int, s string) (int, error) {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, err
+		}
+		return acc + n, nil
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == "" {
+				got := Fold(tt.in, 100, func(acc int, s string) int {
+					n, _ := sumErr(acc, s)
+					return n
+				})
+				assertEq(t, tt.want, got)
+			}
+
+			got, err := FoldErr(tt.in, 100, sumErr)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+
+			got, err = FoldCtx(context.Background(), tt.in, 100, func(_ context.Context, acc int, s string) (int, error) {
+				return sumErr(acc, s)
+			})
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	errNegative := errors.New("negative")
+
+	tests := []struct {
+		name      string
+		in        []int
+		want      int
+		wantErr   string
+		wantIndex int
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: 0,
+		},
+		{
+			name: "single element",
+			in:   []int{5},
+			want: 5,
+		},
+		{
+			name: "multiple elements",
+			in:   []int{5, 1, 9, 3},
+			want: 9,
+		},
+		{
+			name:      "err",
+			in:        []int{5, 1, -1, 9},
+			want:      5,
+			wantErr:   "index 2: negative",
+			wantIndex: 2,
+		},
+	}
+
+	maxErr := func(acc, x int) (int, error) {
+		if x < 0 {
+			return 0, errNegative
+		}
+		return max(acc, x), nil
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == "" {
+				assertEq(t, tt.want, Reduce(tt.in, func(acc, x int) int {
+					return max(acc, x)
+				}))
+			}
+
+			got, err := ReduceErr(tt.in, maxErr)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+
+			got, err = ReduceCtx(context.Background(), tt.in, func(_ context.Context, acc, x int) (int, error) {
+				return maxErr(acc, x)
+			})
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+
+			if tt.wantErr != "" {
+				var indexErr *IndexError
+				assertEq(t, true, errors.As(err, &indexErr))
+				assertEq(t, tt.wantIndex, indexErr.Index)
+			}
+		})
+	}
+}
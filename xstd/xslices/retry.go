@@ -0,0 +1,140 @@
+package xslices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy configures how failed elements are retried by [MapCtxRetry].
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of calls for each element, including the first call.
+	// If zero or negative, each element is only attempted once.
+	MaxAttempts int
+
+	// InitialBackoff is the wait before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff limits the wait between retries.
+	// If zero, the wait is only limited by the maximum time.Duration.
+	MaxBackoff time.Duration
+
+	// Multiplier is the factor the backoff increases by after each retry.
+	// If less than 1, a multiplier of 2 is used.
+	Multiplier float64
+
+	// Jitter randomly reduces each backoff by up to the specified fraction, in the range [0, 1].
+	// For example, a Jitter of 0.2 waits between 80% and 100% of the backoff.
+	Jitter float64
+
+	// Retryable returns if a failed call should be retried.
+	// If nil, all errors are retried.
+	Retryable func(error) bool
+
+	// Clock is used to wait between retries. If nil, the system clock is used.
+	Clock Clock
+}
+
+// backoff returns the wait before the specified retry, starting from 1.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	// Limit the backoff before converting to a time.Duration, which would overflow.
+	limit := float64(math.MaxInt64)
+	if p.MaxBackoff > 0 {
+		limit = float64(p.MaxBackoff)
+	}
+
+	backoff := float64(p.InitialBackoff)
+	for range retry - 1 {
+		if backoff >= limit {
+			break
+		}
+		backoff *= multiplier
+	}
+	backoff = min(backoff, limit)
+
+	if jitter := min(max(p.Jitter, 0), 1); jitter > 0 {
+		backoff -= backoff * jitter * rand.Float64()
+	}
+
+	// float64(math.MaxInt64) rounds up to 2^63, which doesn't fit in a time.Duration.
+	if backoff >= float64(math.MaxInt64) {
+		return math.MaxInt64
+	}
+	return time.Duration(backoff)
+}
+
+func (p RetryPolicy) retryable(err error) bool {
+	return p.Retryable == nil || p.Retryable(err)
+}
+
+// RetryError is the error returned when an element fails after all attempts.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("failed after %d attempts: %v", e.Attempts, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// MapCtxRetry runs the context-aware mapping function to map a slice to a new slice,
+// retrying failed elements as specified by policy.
+//
+// An element is no longer retried if it has used policy.MaxAttempts, the error is not retryable,
+// or ctx is done while waiting for the backoff.
+// This error stops mapping, and is returned as an [*IndexError] wrapping a [*RetryError]
+// with the partially mapped slice, which contains the results for all elements before the failed index.
+func MapCtxRetry[X, Y any](ctx context.Context, xs []X, fn func(context.Context, X) (Y, error), policy RetryPolicy) ([]Y, error) {
+	return MapCtx(ctx, xs, func(ctx context.Context, x X) (Y, error) {
+		return retry(ctx, policy, func(ctx context.Context) (Y, error) {
+			return fn(ctx, x)
+		})
+	})
+}
+
+// retry calls fn until it succeeds, or policy stops retries.
+func retry[Y any](ctx context.Context, policy RetryPolicy, fn func(context.Context) (Y, error)) (Y, error) {
+	clock := clockOrSystem(policy.Clock)
+	maxAttempts := max(policy.MaxAttempts, 1)
+
+	for attempt := 1; ; attempt++ {
+		y, err := fn(ctx)
+		if err == nil {
+			return y, nil
+		}
+		if attempt >= maxAttempts || !policy.retryable(err) {
+			return y, &RetryError{Attempts: attempt, Err: err}
+		}
+
+		if ctxErr := wait(ctx, clock, policy.backoff(attempt)); ctxErr != nil {
+			return y, &RetryError{Attempts: attempt, Err: errors.Join(err, ctxErr)}
+		}
+	}
+}
+
+// wait waits for d using clock, returning early with the context error if ctx is done.
+func wait(ctx context.Context, clock Clock, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-clock.After(d):
+		return nil
+	}
+}
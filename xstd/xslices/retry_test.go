@@ -0,0 +1,219 @@
+package xslices
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock does not wait, instead advancing the current time, and records the waits.
+type fakeClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	waits []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.waits = append(c.waits, d)
+	c.now = c.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+// blockingClock never finishes waiting.
+type blockingClock struct{}
+
+func (blockingClock) Now() time.Time {
+	return time.Time{}
+}
+
+func (blockingClock) After(time.Duration) <-chan time.Time {
+	return nil
+}
+
+// flaky returns a function that fails for each element the specified number of times
+// with errFlaky before succeeding, and records the number of calls per element.
+func flaky(failures map[int]int, errFlaky error) (func(context.Context, int) (int, error), map[int]int) {
+	calls := make(map[int]int)
+	return func(_ context.Context, x int) (int, error) {
+		calls[x]++
+		if calls[x] <= failures[x] {
+			return 0, errFlaky
+		}
+		return x * 10, nil
+	}, calls
+}
+
+func TestMapCtxRetry(t *testing.T) {
+	errFlaky := errors.New("flaky")
+	errFatal := errors.New("fatal")
+
+	tests := []struct {
+		name      string
+		in        []int
+		failures  map[int]int
+		policy    RetryPolicy
+		want      []int
+		wantErr   string
+		wantCalls map[int]int
+		wantWaits []time.Duration
+	}{
+		{
+			name:      "nil",
+			in:        nil,
+			want:      nil,
+			wantCalls: map[int]int{},
+		},
+		{
+			name:      "no failures",
+			in:        []int{1, 2},
+			policy:    RetryPolicy{MaxAttempts: 3},
+			want:      []int{10, 20},
+			wantCalls: map[int]int{1: 1, 2: 1},
+		},
+		{
+			name:      "retries until success",
+			in:        []int{1, 2, 3},
+			failures:  map[int]int{2: 2},
+			policy:    RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second},
+			want:      []int{10, 20, 30},
+			wantCalls: map[int]int{1: 1, 2: 3, 3: 1},
+			wantWaits: []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name:      "exhausts attempts",
+			in:        []int{1, 2, 3},
+			failures:  map[int]int{2: 5},
+			policy:    RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second, Multiplier: 3},
+			want:      []int{10},
+			wantErr:   "index 1: failed after 3 attempts: flaky",
+			wantCalls: map[int]int{1: 1, 2: 3},
+			wantWaits: []time.Duration{time.Second, 3 * time.Second},
+		},
+		{
+			name:      "zero attempts only attempts once",
+			in:        []int{1},
+			failures:  map[int]int{1: 1},
+			want:      []int{},
+			wantErr:   "index 0: failed after 1 attempts: flaky",
+			wantCalls: map[int]int{1: 1},
+		},
+		{
+			name:     "max backoff",
+			in:       []int{1},
+			failures: map[int]int{1: 4},
+			policy: RetryPolicy{
+				MaxAttempts:    5,
+				InitialBackoff: time.Second,
+				MaxBackoff:     3 * time.Second,
+			},
+			want:      []int{10},
+			wantCalls: map[int]int{1: 5},
+			wantWaits: []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second},
+		},
+		{
+			name:     "not retryable",
+			in:       []int{1},
+			failures: map[int]int{1: 1},
+			policy: RetryPolicy{
+				MaxAttempts: 5,
+				Retryable: func(err error) bool {
+					return errors.Is(err, errFatal)
+				},
+			},
+			want:      []int{},
+			wantErr:   "index 0: failed after 1 attempts: flaky",
+			wantCalls: map[int]int{1: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &fakeClock{}
+			tt.policy.Clock = clock
+
+			fn, calls := flaky(tt.failures, errFlaky)
+			got, err := MapCtxRetry(context.Background(), tt.in, fn, tt.policy)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+			assertEq(t, tt.wantCalls, calls)
+			assertEq(t, tt.wantWaits, clock.waits)
+
+			if tt.wantErr != "" {
+				var retryErr *RetryError
+				assertEq(t, true, errors.As(err, &retryErr))
+				assertEq(t, tt.wantCalls[tt.in[len(got)]], retryErr.Attempts)
+				assertEq(t, true, errors.Is(err, errFlaky))
+			}
+		})
+	}
+}
+
+func TestMapCtxRetry_ContextDone(t *testing.T) {
+	errFlaky := errors.New("flaky")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	fn, calls := flaky(map[int]int{1: 10}, errFlaky)
+	policy := RetryPolicy{
+		MaxAttempts:    10,
+		InitialBackoff: time.Hour,
+		Clock:          blockingClock{},
+	}
+
+	got, err := MapCtxRetry(ctx, []int{1, 2}, fn, policy)
+	assertErr(t, "index 0: failed after 1 attempts: flaky", err)
+	assertEq(t, []int{}, got)
+	assertEq(t, map[int]int{1: 1}, calls)
+	assertEq(t, true, errors.Is(err, errFlaky))
+	assertEq(t, true, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Run("default multiplier", func(t *testing.T) {
+		p := RetryPolicy{InitialBackoff: time.Second}
+		assertEq(t, time.Second, p.backoff(1))
+		assertEq(t, 8*time.Second, p.backoff(4))
+	})
+
+	t.Run("large retry is capped", func(t *testing.T) {
+		p := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: time.Minute}
+		assertEq(t, time.Minute, p.backoff(1000))
+	})
+
+	t.Run("large retry without max does not overflow", func(t *testing.T) {
+		p := RetryPolicy{InitialBackoff: time.Second}
+		for _, retry := range []int{34, 35, 64, 1000} {
+			got := p.backoff(retry)
+			if got < p.backoff(retry-1) {
+				t.Fatalf("backoff(%v) = %v, expected at least backoff(%v)", retry, got, retry-1)
+			}
+		}
+		assertEq(t, time.Duration(math.MaxInt64), p.backoff(1000))
+	})
+
+	t.Run("jitter", func(t *testing.T) {
+		p := RetryPolicy{InitialBackoff: time.Second, Multiplier: 2, Jitter: 0.25}
+		for range 100 {
+			got := p.backoff(2)
+			if got < 1500*time.Millisecond || got > 2*time.Second {
+				t.Fatalf("backoff %v outside jitter range", got)
+			}
+		}
+	})
+}
@@ -0,0 +1,276 @@
+package xslices
+
+import (
+	"context"
+	"iter"
+	"runtime"
+	"runtime/debug"
+	"sync"
+)
+
+// SeqOptions configures MapSeq.
+type SeqOptions struct {
+	// Concurrency is the maximum number of concurrent calls to the mapping function.
+	// If zero or negative, GOMAXPROCS is used.
+	Concurrency int
+
+	// Unordered yields results as soon as they complete, rather than in the order of the input.
+	Unordered bool
+}
+
+func (o SeqOptions) concurrency() int {
+	if o.Concurrency <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return o.Concurrency
+}
+
+// seqResult is the result of mapping the element at index i.
+type seqResult[Y any] struct {
+	i     int
+	y     Y
+	err   error
+	panic *panicError
+}
+
+// MapSeq runs the context-aware mapping function concurrently over the values in seq,
+// returning a sequence of the results as they complete.
+// Results are in the same order as seq, unless opts.Unordered is set.
+//
+// seq is iterated in a separate goroutine, so completed results are yielded
+// even while seq is blocked producing the next value.
+//
+// Errors do not stop mapping, and are yielded as an [*IndexError], where the index is the position in seq.
+// Once ctx is done, no new calls are started, and after in-flight results,
+// the context error is yielded as the final result.
+// When the consumer stops iterating, the context passed to in-flight calls is canceled,
+// and iteration returns once they complete. If seq is blocked producing a value,
+// it's stopped in the background once that value is produced.
+// If the mapping function or seq panics, the panic is propagated to the consumer.
+// Panics from the mapping function include the stack of the panicking goroutine.
+func MapSeq[X, Y any](ctx context.Context, seq iter.Seq[X], fn func(context.Context, X) (Y, error), opts SeqOptions) iter.Seq2[Y, error] {
+	return func(yield func(Y, error) bool) {
+		var wg sync.WaitGroup // tracks calls to fn, but not the feeder.
+		defer wg.Wait()
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var pending seqPending[Y]
+		if opts.Unordered {
+			pending = newUnorderedPending[Y](opts.concurrency())
+		} else {
+			pending = newOrderedPending[Y](opts.concurrency())
+		}
+
+		yieldResult := func(r seqResult[Y]) bool {
+			if r.panic != nil {
+				cancel()
+				wg.Wait()
+				panic(r.panic)
+			}
+			if r.err != nil {
+				return yield(r.y, &IndexError{Index: r.i, Err: r.err})
+			}
+			return yield(r.y, nil)
+		}
+
+		inputs, feed := feedSeq(ctx, seq)
+
+		var (
+			i      int
+			ctxErr error
+		)
+		for inputs != nil || pending.len() > 0 {
+			// Only accept new inputs below the concurrency limit.
+			var (
+				accept <-chan X
+				done   <-chan struct{}
+			)
+			if inputs != nil && !pending.full() {
+				accept = inputs
+				done = ctx.Done()
+			}
+
+			select {
+			case r := <-pending.ready():
+				pending.pop()
+				if !yieldResult(r) {
+					return
+				}
+
+			case x, ok := <-accept:
+				if !ok {
+					if feed.panic != nil {
+						panic(feed.panic)
+					}
+					ctxErr = feed.err
+					inputs = nil
+					continue
+				}
+				if ctxErr = ctx.Err(); ctxErr != nil {
+					inputs = nil
+					continue
+				}
+
+				complete := pending.add()
+				wg.Add(1)
+				go func(i int, x X) {
+					defer wg.Done()
+					complete(callSeq(ctx, i, x, fn))
+				}(i, x)
+				i++
+
+			case <-done:
+				ctxErr = ctx.Err()
+				inputs = nil
+			}
+		}
+
+		if ctxErr != nil {
+			var zero Y
+			yield(zero, &IndexError{Index: i, Err: ctxErr})
+		}
+	}
+}
+
+// feedResult records why the feeder goroutine stopped.
+// It's only read after the inputs channel is closed.
+type feedResult struct {
+	panic any   // value seq panicked with, if any.
+	err   error // context error, if the feeder stopped as ctx was done.
+}
+
+// feedSeq iterates over seq in a new goroutine, sending each value on the returned channel
+// until seq is exhausted or ctx is done.
+// The channel is closed once the goroutine stops, after the returned result is set.
+func feedSeq[X any](ctx context.Context, seq iter.Seq[X]) (<-chan X, *feedResult) {
+	inputs := make(chan X)
+	feed := &feedResult{}
+	go func() {
+		defer close(inputs)
+		defer func() {
+			feed.panic = recover()
+		}()
+
+		for x := range seq {
+			select {
+			case inputs <- x:
+			case <-ctx.Done():
+				feed.err = ctx.Err()
+				return
+			}
+		}
+	}()
+	return inputs, feed
+}
+
+func callSeq[X, Y any](ctx context.Context, i int, x X, fn func(context.Context, X) (Y, error)) (r seqResult[Y]) {
+	r.i = i
+	defer func() {
+		if p := recover(); p != nil {
+			r.panic = &panicError{index: i, value: p, stack: debug.Stack()}
+		}
+	}()
+
+	r.y, r.err = fn(ctx, x)
+	return r
+}
+
+// seqPending tracks in-flight calls for MapSeq.
+// It's only used by the consumer goroutine, other than the functions returned by add.
+type seqPending[Y any] interface {
+	// add tracks a new call, returning a function to call with the result.
+	add() (complete func(seqResult[Y]))
+
+	// len returns the number of pending results.
+	len() int
+
+	// full returns if the number of pending results is at the concurrency limit.
+	full() bool
+
+	// ready returns a channel that receives the next result to yield,
+	// or nil if there are no pending results.
+	ready() <-chan seqResult[Y]
+
+	// pop marks the result received from ready as yielded.
+	pop()
+}
+
+// orderedPending yields results in the order they were added.
+type orderedPending[Y any] struct {
+	limit   int
+	futures []chan seqResult[Y]
+}
+
+func newOrderedPending[Y any](limit int) *orderedPending[Y] {
+	return &orderedPending[Y]{limit: limit}
+}
+
+func (p *orderedPending[Y]) add() func(seqResult[Y]) {
+	future := make(chan seqResult[Y], 1)
+	p.futures = append(p.futures, future)
+	return func(r seqResult[Y]) {
+		future <- r
+	}
+}
+
+func (p *orderedPending[Y]) len() int {
+	return len(p.futures)
+}
+
+func (p *orderedPending[Y]) full() bool {
+	return len(p.futures) >= p.limit
+}
+
+func (p *orderedPending[Y]) ready() <-chan seqResult[Y] {
+	if len(p.futures) == 0 {
+		return nil
+	}
+	return p.futures[0]
+}
+
+func (p *orderedPending[Y]) pop() {
+	p.futures[0] = nil
+	p.futures = p.futures[1:]
+}
+
+// unorderedPending yields results in the order they complete.
+type unorderedPending[Y any] struct {
+	limit    int
+	inFlight int
+	results  chan seqResult[Y]
+}
+
+func newUnorderedPending[Y any](limit int) *unorderedPending[Y] {
+	return &unorderedPending[Y]{
+		limit:   limit,
+		results: make(chan seqResult[Y], limit),
+	}
+}
+
+func (p *unorderedPending[Y]) add() func(seqResult[Y]) {
+	p.inFlight++
+	return func(r seqResult[Y]) {
+		p.results <- r
+	}
+}
+
+func (p *unorderedPending[Y]) len() int {
+	return p.inFlight
+}
+
+func (p *unorderedPending[Y]) full() bool {
+	return p.inFlight >= p.limit
+}
+
+func (p *unorderedPending[Y]) ready() <-chan seqResult[Y] {
+	if p.inFlight == 0 {
+		return nil
+	}
+	return p.results
+}
+
+func (p *unorderedPending[Y]) pop() {
+	p.inFlight--
+}
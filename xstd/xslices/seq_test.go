@@ -0,0 +1,267 @@
+package xslices
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// seqResults collects the results and errors of a sequence as strings.
+func seqResults(seq iter.Seq2[int, error]) []string {
+	var results []string
+	for y, err := range seq {
+		if err != nil {
+			results = append(results, "err: "+err.Error())
+			continue
+		}
+		results = append(results, strconv.Itoa(y))
+	}
+	return results
+}
+
+// naturals returns an infinite sequence of natural numbers, counting the number of values pulled.
+func naturals(pulled *atomic.Int32) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled.Add(1)
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	atoi := func(_ context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	}
+
+	tests := []struct {
+		name string
+		in   []string
+		want []string
+	}{
+		{
+			name: "empty",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "multiple elements",
+			in:   []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+			want: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"},
+		},
+		{
+			name: "errors do not stop mapping",
+			in:   []string{"1", "err", "3"},
+			want: []string{"1", `err: index 1: strconv.Atoi: parsing "err": invalid syntax`, "3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, concurrency := range []int{0, 1, 2, 100} {
+				opts := SeqOptions{Concurrency: concurrency}
+				got := seqResults(MapSeq(context.Background(), slices.Values(tt.in), atoi, opts))
+				assertEq(t, tt.want, got)
+
+				opts.Unordered = true
+				got = seqResults(MapSeq(context.Background(), slices.Values(tt.in), atoi, opts))
+				slices.Sort(got)
+				want := slices.Clone(tt.want)
+				slices.Sort(want)
+				assertEq(t, want, got)
+			}
+		})
+	}
+}
+
+func TestMapSeq_Concurrency(t *testing.T) {
+	const limit = 3
+
+	for _, unordered := range []bool{false, true} {
+		var inFlight, maxInFlight atomic.Int32
+		fn := func(_ context.Context, x int) (int, error) {
+			cur := inFlight.Add(1)
+			defer inFlight.Add(-1)
+
+			for {
+				prev := maxInFlight.Load()
+				if cur <= prev || maxInFlight.CompareAndSwap(prev, cur) {
+					break
+				}
+			}
+			return x, nil
+		}
+
+		var pulled atomic.Int32
+		var n int
+		for _, err := range MapSeq(context.Background(), naturals(&pulled), fn, SeqOptions{Concurrency: limit, Unordered: unordered}) {
+			assertErr(t, "", err)
+			n++
+			if n == 100 {
+				break
+			}
+		}
+
+		if got := maxInFlight.Load(); got > limit {
+			t.Fatalf("max concurrent calls %v exceeds limit %v", got, limit)
+		}
+		if got := pulled.Load(); got > 100+limit+1 {
+			t.Fatalf("pulled %v values from seq, expected at most %v", got, 100+limit+1)
+		}
+	}
+}
+
+func TestMapSeq_Unordered(t *testing.T) {
+	release := make(chan struct{})
+	fn := func(_ context.Context, x int) (int, error) {
+		if x == 0 {
+			<-release
+		}
+		return x, nil
+	}
+
+	var got []int
+	for y, err := range MapSeq(context.Background(), slices.Values([]int{0, 1, 2}), fn, SeqOptions{Concurrency: 2, Unordered: true}) {
+		assertErr(t, "", err)
+		got = append(got, y)
+		if y == 2 {
+			close(release)
+		}
+	}
+
+	// The slow first element does not block later elements.
+	assertEq(t, []int{1, 2, 0}, got)
+}
+
+func TestMapSeq_BreakCancels(t *testing.T) {
+	for _, unordered := range []bool{false, true} {
+		var started, canceled, finished atomic.Int32
+		fn := func(ctx context.Context, x int) (int, error) {
+			started.Add(1)
+			defer finished.Add(1)
+
+			if x == 0 {
+				return x, nil
+			}
+
+			// Block until the consumer breaks.
+			<-ctx.Done()
+			canceled.Add(1)
+			return 0, ctx.Err()
+		}
+
+		var pulled atomic.Int32
+		for y, err := range MapSeq(context.Background(), naturals(&pulled), fn, SeqOptions{Concurrency: 4, Unordered: unordered}) {
+			assertErr(t, "", err)
+			assertEq(t, 0, y)
+			break
+		}
+
+		// All in-flight calls are canceled and complete before iteration returns.
+		assertEq(t, started.Load(), finished.Load())
+		assertEq(t, started.Load()-1, canceled.Load())
+		if got := pulled.Load(); got > 5 {
+			t.Fatalf("expected seq to stop after break, pulled %v values", got)
+		}
+	}
+}
+
+func TestMapSeq_BlockedProducer(t *testing.T) {
+	double := func(_ context.Context, x int) (int, error) { return x * 2, nil }
+	for _, unordered := range []bool{false, true} {
+		release := make(chan struct{})
+		seq := func(yield func(int) bool) {
+			if !yield(1) {
+				return
+			}
+
+			// Block until the consumer has received the first result.
+			<-release
+			yield(2)
+		}
+
+		var got []int
+		for y, err := range MapSeq(context.Background(), seq, double, SeqOptions{Concurrency: 2, Unordered: unordered}) {
+			assertErr(t, "", err)
+			got = append(got, y)
+			if y == 2 {
+				close(release)
+			}
+		}
+		assertEq(t, []int{2, 4}, got)
+	}
+}
+
+func TestMapSeq_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fn := func(_ context.Context, x int) (int, error) {
+		if x == 5 {
+			cancel()
+		}
+		return x, nil
+	}
+
+	var pulled atomic.Int32
+	var got []int
+	var gotErr error
+	for y, err := range MapSeq(ctx, naturals(&pulled), fn, SeqOptions{Concurrency: 1}) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, y)
+	}
+
+	assertEq(t, []int{0, 1, 2, 3, 4, 5}, got)
+	assertErr(t, "index 6: "+context.Canceled.Error(), gotErr)
+	assertEq(t, true, errors.Is(gotErr, context.Canceled))
+}
+
+func TestMapSeq_Panic(t *testing.T) {
+	errPanic := errors.New("panic error")
+	fn := func(_ context.Context, x int) (int, error) {
+		if x == 2 {
+			panicInWorker(errPanic)
+		}
+		return x, nil
+	}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected panic")
+		}
+
+		err, ok := r.(error)
+		if !ok {
+			t.Fatalf("expected panic value to be an error, got %T", r)
+		}
+		assertEq(t, true, errors.Is(err, errPanic))
+		assertErr(t, "panic in index 2: panic error", err)
+	}()
+
+	for range MapSeq(context.Background(), slices.Values([]int{0, 1, 2, 3}), fn, SeqOptions{Concurrency: 2}) {
+	}
+}
+
+func TestMapSeq_SeqPanic(t *testing.T) {
+	double := func(_ context.Context, x int) (int, error) { return x * 2, nil }
+	seq := func(yield func(int) bool) {
+		yield(1)
+		panic("seq failed")
+	}
+
+	defer func() {
+		assertEq(t, "seq failed", recover())
+	}()
+
+	for range MapSeq(context.Background(), seq, double, SeqOptions{Concurrency: 2}) {
+	}
+	t.Fatal("expected panic")
+}
@@ -0,0 +1,215 @@
+package xslices
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+)
+
+// The sorted operations treat their inputs as sorted multisets:
+// an element that occurs multiple times is matched against occurrences in the other slice one at a time.
+
+// SortedUnion returns the sorted union of the sorted slices a and b.
+// Elements in both slices are only included once.
+func SortedUnion[T cmp.Ordered](a, b []T) []T {
+	return SortedUnionFunc(a, b, cmp.Compare[T])
+}
+
+// SortedUnionFunc returns the union of the slices a and b, which must both be sorted by cmp.
+// Elements in both slices are only included once, using the element from a.
+func SortedUnionFunc[T any](a, b []T, cmp func(T, T) int) []T {
+	union := make([]T, 0, max(len(a), len(b)))
+	var i, j int
+	for i < len(a) && j < len(b) {
+		switch c := cmp(a[i], b[j]); {
+		case c < 0:
+			union = append(union, a[i])
+			i++
+		case c > 0:
+			union = append(union, b[j])
+			j++
+		default:
+			union = append(union, a[i])
+			i++
+			j++
+		}
+	}
+	union = append(union, a[i:]...)
+	union = append(union, b[j:]...)
+	return union
+}
+
+// SortedIntersect returns the sorted elements that are in both of the sorted slices a and b.
+func SortedIntersect[T cmp.Ordered](a, b []T) []T {
+	return SortedIntersectFunc(a, b, cmp.Compare[T])
+}
+
+// SortedIntersectFunc returns the elements that are in both of the slices a and b,
+// which must both be sorted by cmp. Elements are taken from a.
+func SortedIntersectFunc[T any](a, b []T, cmp func(T, T) int) []T {
+	intersect := make([]T, 0, min(len(a), len(b)))
+	var i, j int
+	for i < len(a) && j < len(b) {
+		switch c := cmp(a[i], b[j]); {
+		case c < 0:
+			i++
+		case c > 0:
+			j++
+		default:
+			intersect = append(intersect, a[i])
+			i++
+			j++
+		}
+	}
+	return intersect
+}
+
+// SortedDifference returns the sorted elements in the sorted slice a that are not in the sorted slice b.
+func SortedDifference[T cmp.Ordered](a, b []T) []T {
+	return SortedDifferenceFunc(a, b, cmp.Compare[T])
+}
+
+// SortedDifferenceFunc returns the elements in a that are not in b,
+// where a and b must both be sorted by cmp.
+func SortedDifferenceFunc[T any](a, b []T, cmp func(T, T) int) []T {
+	diff := make([]T, 0, len(a))
+	var i, j int
+	for i < len(a) && j < len(b) {
+		switch c := cmp(a[i], b[j]); {
+		case c < 0:
+			diff = append(diff, a[i])
+			i++
+		case c > 0:
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	return append(diff, a[i:]...)
+}
+
+// MergeSorted merges the sorted slices into a single sorted slice, retaining duplicates.
+func MergeSorted[T cmp.Ordered](xss ...[]T) []T {
+	return MergeSortedFunc(xss, cmp.Compare[T])
+}
+
+// MergeSortedFunc merges the slices, which must all be sorted by cmp, into a single sorted slice,
+// retaining duplicates. Equal elements are ordered by the index of their slice in xss.
+// It takes O(n log k) time for n total elements across k slices.
+func MergeSortedFunc[T any](xss [][]T, cmp func(T, T) int) []T {
+	var total int
+	h := &mergeHeap[T]{cmp: cmp}
+	for i, xs := range xss {
+		total += len(xs)
+		if len(xs) > 0 {
+			h.cursors = append(h.cursors, mergeCursor[T]{xs: xs, slice: i})
+		}
+	}
+
+	switch len(h.cursors) {
+	case 0:
+		return make([]T, 0)
+	case 1:
+		return slices.Clone(h.cursors[0].xs)
+	case 2:
+		return mergeTwo(h.cursors[0].xs, h.cursors[1].xs, cmp)
+	}
+
+	merged := make([]T, 0, total)
+	heap.Init(h)
+	for len(h.cursors) > 0 {
+		top := &h.cursors[0]
+		merged = append(merged, top.xs[0])
+		if top.xs = top.xs[1:]; len(top.xs) > 0 {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return merged
+}
+
+// mergeTwo merges two sorted slices, preferring a for equal elements.
+func mergeTwo[T any](a, b []T, cmp func(T, T) int) []T {
+	merged := make([]T, 0, len(a)+len(b))
+	var i, j int
+	for i < len(a) && j < len(b) {
+		if cmp(a[i], b[j]) <= 0 {
+			merged = append(merged, a[i])
+			i++
+		} else {
+			merged = append(merged, b[j])
+			j++
+		}
+	}
+	merged = append(merged, a[i:]...)
+	return append(merged, b[j:]...)
+}
+
+type mergeCursor[T any] struct {
+	xs    []T // remaining elements, never empty in the heap.
+	slice int // index in the input, used to order equal elements.
+}
+
+type mergeHeap[T any] struct {
+	cursors []mergeCursor[T]
+	cmp     func(T, T) int
+}
+
+func (h *mergeHeap[T]) Len() int { return len(h.cursors) }
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	if c := h.cmp(h.cursors[i].xs[0], h.cursors[j].xs[0]); c != 0 {
+		return c < 0
+	}
+	return h.cursors[i].slice < h.cursors[j].slice
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) { h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i] }
+
+func (h *mergeHeap[T]) Push(x any) { h.cursors = append(h.cursors, x.(mergeCursor[T])) }
+
+func (h *mergeHeap[T]) Pop() any {
+	last := h.cursors[len(h.cursors)-1]
+	h.cursors = h.cursors[:len(h.cursors)-1]
+	return last
+}
+
+// DedupSorted returns the sorted slice xs with consecutive duplicates removed.
+// Unlike [slices.Compact], xs is not modified.
+func DedupSorted[T cmp.Ordered](xs []T) []T {
+	return DedupSortedFunc(xs, cmp.Compare[T])
+}
+
+// DedupSortedFunc returns the slice xs, which must be sorted by cmp, with consecutive duplicates removed.
+// The first of each run of equal elements is retained.
+// Unlike [slices.CompactFunc], xs is not modified.
+func DedupSortedFunc[T any](xs []T, cmp func(T, T) int) []T {
+	if xs == nil {
+		return nil
+	}
+
+	deduped := make([]T, 0, len(xs))
+	for i := range xs {
+		if len(deduped) > 0 && cmp(deduped[len(deduped)-1], xs[i]) == 0 {
+			continue
+		}
+		deduped = append(deduped, xs[i])
+	}
+	return deduped
+}
+
+// BinarySearchByKey searches for target in xs, which must be sorted by the key returned by key.
+// It returns the position where target is found, or the position where it would be inserted,
+// and a bool for whether the target is found.
+func BinarySearchByKey[T any, K cmp.Ordered](xs []T, target K, key func(T) K) (int, bool) {
+	return BinarySearchByKeyFunc(xs, target, key, cmp.Compare[K])
+}
+
+// BinarySearchByKeyFunc is similar to [BinarySearchByKey], but compares keys using cmp.
+func BinarySearchByKeyFunc[T, K any](xs []T, target K, key func(T) K, cmp func(K, K) int) (int, bool) {
+	return slices.BinarySearchFunc(xs, target, func(x T, target K) int {
+		return cmp(key(x), target)
+	})
+}
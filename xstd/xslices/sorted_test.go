@@ -0,0 +1,364 @@
+package xslices
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"go.prashantv.com/container/set"
+)
+
+func TestSortedSetOps(t *testing.T) {
+	tests := []struct {
+		name          string
+		a             []int
+		b             []int
+		wantUnion     []int
+		wantIntersect []int
+		wantDiff      []int
+	}{
+		{
+			name:          "both empty",
+			wantUnion:     []int{},
+			wantIntersect: []int{},
+			wantDiff:      []int{},
+		},
+		{
+			name:          "a empty",
+			b:             []int{1, 2},
+			wantUnion:     []int{1, 2},
+			wantIntersect: []int{},
+			wantDiff:      []int{},
+		},
+		{
+			name:          "b empty",
+			a:             []int{1, 2},
+			wantUnion:     []int{1, 2},
+			wantIntersect: []int{},
+			wantDiff:      []int{1, 2},
+		},
+		{
+			name:          "overlapping",
+			a:             []int{1, 3, 5, 7},
+			b:             []int{3, 4, 5, 8},
+			wantUnion:     []int{1, 3, 4, 5, 7, 8},
+			wantIntersect: []int{3, 5},
+			wantDiff:      []int{1, 7},
+		},
+		{
+			name:          "disjoint",
+			a:             []int{1, 2},
+			b:             []int{3, 4},
+			wantUnion:     []int{1, 2, 3, 4},
+			wantIntersect: []int{},
+			wantDiff:      []int{1, 2},
+		},
+		{
+			name:          "duplicates as multisets",
+			a:             []int{1, 1, 1, 2},
+			b:             []int{1, 2, 2},
+			wantUnion:     []int{1, 1, 1, 2, 2},
+			wantIntersect: []int{1, 2},
+			wantDiff:      []int{1, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertEq(t, tt.wantUnion, SortedUnion(tt.a, tt.b))
+			assertEq(t, tt.wantIntersect, SortedIntersect(tt.a, tt.b))
+			assertEq(t, tt.wantDiff, SortedDifference(tt.a, tt.b))
+
+			// Comparator forms using reverse order.
+			reverse := func(a, b int) int { return cmp.Compare(b, a) }
+			assertEq(t, reversed(tt.wantUnion), SortedUnionFunc(reversed(tt.a), reversed(tt.b), reverse))
+			assertEq(t, reversed(tt.wantIntersect), SortedIntersectFunc(reversed(tt.a), reversed(tt.b), reverse))
+			assertEq(t, reversed(tt.wantDiff), SortedDifferenceFunc(reversed(tt.a), reversed(tt.b), reverse))
+		})
+	}
+}
+
+func TestSortedSetOps_Random(t *testing.T) {
+	for range 100 {
+		a, b := randomSorted(rand.IntN(50)), randomSorted(rand.IntN(50))
+		setA, setB := set.New(a...), set.New(b...)
+
+		assertEq(t, set.Ordered(setA.Union(setB)), SortedUnion(a, b))
+		assertEq(t, set.Ordered(setA.Intersect(setB)), SortedIntersect(a, b))
+
+		wantDiff := []int{}
+		for _, x := range set.Ordered(setA) {
+			if !setB.Contains(x) {
+				wantDiff = append(wantDiff, x)
+			}
+		}
+		assertEq(t, wantDiff, SortedDifference(a, b))
+	}
+}
+
+func TestSortedFunc_KeepsElementsFromA(t *testing.T) {
+	a := []string{"a", "B"}
+	b := []string{"A", "b", "c"}
+	cmpFold := func(x, y string) int {
+		return strings.Compare(strings.ToLower(x), strings.ToLower(y))
+	}
+
+	assertEq(t, []string{"a", "B", "c"}, SortedUnionFunc(a, b, cmpFold))
+	assertEq(t, []string{"a", "B"}, SortedIntersectFunc(a, b, cmpFold))
+}
+
+func TestMergeSorted(t *testing.T) {
+	tests := []struct {
+		name string
+		in   [][]int
+		want []int
+	}{
+		{
+			name: "no slices",
+			want: []int{},
+		},
+		{
+			name: "empty slices",
+			in:   [][]int{nil, {}},
+			want: []int{},
+		},
+		{
+			name: "single slice",
+			in:   [][]int{{1, 2}},
+			want: []int{1, 2},
+		},
+		{
+			name: "two slices",
+			in:   [][]int{{1, 4}, {2, 3, 5}},
+			want: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name: "many slices with duplicates",
+			in:   [][]int{{1, 5, 9}, {}, {2, 5}, {0, 5, 10}, {3}},
+			want: []int{0, 1, 2, 3, 5, 5, 5, 9, 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertEq(t, tt.want, MergeSorted(tt.in...))
+		})
+	}
+
+	t.Run("random", func(t *testing.T) {
+		for range 100 {
+			xss := make([][]int, rand.IntN(10))
+			var want []int
+			for i := range xss {
+				xss[i] = randomSorted(rand.IntN(20))
+				want = append(want, xss[i]...)
+			}
+			slices.Sort(want)
+			if want == nil {
+				want = []int{}
+			}
+			assertEq(t, want, MergeSorted(xss...))
+		}
+	})
+
+	t.Run("stable", func(t *testing.T) {
+		type item struct {
+			key   int
+			slice int
+		}
+		byKey := func(a, b item) int { return cmp.Compare(a.key, b.key) }
+
+		xss := [][]item{
+			{{1, 0}, {2, 0}},
+			{{1, 1}, {2, 1}},
+			{{1, 2}},
+		}
+		want := []item{{1, 0}, {1, 1}, {1, 2}, {2, 0}, {2, 1}}
+		assertEq(t, want, MergeSortedFunc(xss, byKey))
+		assertEq(t, []item{{1, 0}, {1, 1}, {2, 0}, {2, 1}}, MergeSortedFunc(xss[:2], byKey))
+	})
+
+	t.Run("does not alias input", func(t *testing.T) {
+		xs := []int{1, 2}
+		merged := MergeSorted(xs)
+		merged[0] = 100
+		assertEq(t, []int{1, 2}, xs)
+	})
+}
+
+func TestDedupSorted(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "no duplicates",
+			in:   []int{1, 2, 3},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "duplicates",
+			in:   []int{1, 1, 2, 3, 3, 3},
+			want: []int{1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := slices.Clone(tt.in)
+			assertEq(t, tt.want, DedupSorted(in))
+			assertEq(t, tt.in, in)
+		})
+	}
+
+	got := DedupSortedFunc([]string{"a", "A", "b", "B", "c"}, func(x, y string) int {
+		return strings.Compare(strings.ToLower(x), strings.ToLower(y))
+	})
+	assertEq(t, []string{"a", "b", "c"}, got)
+}
+
+func TestBinarySearchByKey(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	users := []user{{1, "a"}, {3, "b"}, {5, "c"}}
+	id := func(u user) int { return u.id }
+
+	tests := []struct {
+		target    int
+		wantIdx   int
+		wantFound bool
+	}{
+		{target: 0, wantIdx: 0, wantFound: false},
+		{target: 1, wantIdx: 0, wantFound: true},
+		{target: 4, wantIdx: 2, wantFound: false},
+		{target: 5, wantIdx: 2, wantFound: true},
+		{target: 6, wantIdx: 3, wantFound: false},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.target), func(t *testing.T) {
+			idx, found := BinarySearchByKey(users, tt.target, id)
+			assertEq(t, tt.wantIdx, idx)
+			assertEq(t, tt.wantFound, found)
+
+			idx, found = BinarySearchByKeyFunc(users, tt.target, id, cmp.Compare[int])
+			assertEq(t, tt.wantIdx, idx)
+			assertEq(t, tt.wantFound, found)
+		})
+	}
+}
+
+func reversed[T any](xs []T) []T {
+	xs = slices.Clone(xs)
+	slices.Reverse(xs)
+	if xs == nil {
+		return []T{}
+	}
+	return xs
+}
+
+// randomSorted returns n distinct sorted integers.
+func randomSorted(n int) []int {
+	s := set.New[int]()
+	for len(s) < n {
+		s.Insert(rand.IntN(n * 3))
+	}
+	return set.Ordered(s)
+}
+
+var sortedSink []int
+
+func BenchmarkSortedIntersect(b *testing.B) {
+	for _, n := range []int{100, 10000} {
+		xs, ys := randomSorted(n), randomSorted(n)
+		setX, setY := set.New(xs...), set.New(ys...)
+
+		b.Run(fmt.Sprintf("n=%v/SortedIntersect", n), func(b *testing.B) {
+			for b.Loop() {
+				sortedSink = SortedIntersect(xs, ys)
+			}
+		})
+		b.Run(fmt.Sprintf("n=%v/set.Intersect", n), func(b *testing.B) {
+			for b.Loop() {
+				sortedSink = set.Ordered(set.New(xs...).Intersect(set.New(ys...)))
+			}
+		})
+		b.Run(fmt.Sprintf("n=%v/set.Intersect prebuilt", n), func(b *testing.B) {
+			for b.Loop() {
+				setX.Intersect(setY)
+			}
+		})
+	}
+}
+
+func BenchmarkSortedUnion(b *testing.B) {
+	for _, n := range []int{100, 10000} {
+		xs, ys := randomSorted(n), randomSorted(n)
+		setX, setY := set.New(xs...), set.New(ys...)
+
+		b.Run(fmt.Sprintf("n=%v/SortedUnion", n), func(b *testing.B) {
+			for b.Loop() {
+				sortedSink = SortedUnion(xs, ys)
+			}
+		})
+		b.Run(fmt.Sprintf("n=%v/set.Union", n), func(b *testing.B) {
+			for b.Loop() {
+				sortedSink = set.Ordered(set.New(xs...).Union(set.New(ys...)))
+			}
+		})
+		b.Run(fmt.Sprintf("n=%v/set.Union prebuilt", n), func(b *testing.B) {
+			for b.Loop() {
+				setX.Union(setY)
+			}
+		})
+	}
+}
+
+func BenchmarkSortedDifference(b *testing.B) {
+	for _, n := range []int{100, 10000} {
+		xs, ys := randomSorted(n), randomSorted(n)
+
+		b.Run(fmt.Sprintf("n=%v/SortedDifference", n), func(b *testing.B) {
+			for b.Loop() {
+				sortedSink = SortedDifference(xs, ys)
+			}
+		})
+		b.Run(fmt.Sprintf("n=%v/set", n), func(b *testing.B) {
+			for b.Loop() {
+				setX, setY := set.New(xs...), set.New(ys...)
+				for y := range setY {
+					setX.Delete(y)
+				}
+				sortedSink = set.Ordered(setX)
+			}
+		})
+	}
+}
+
+func BenchmarkMergeSorted(b *testing.B) {
+	xss := make([][]int, 8)
+	for i := range xss {
+		xss[i] = randomSorted(1000)
+	}
+
+	b.Run("MergeSorted", func(b *testing.B) {
+		for b.Loop() {
+			sortedSink = MergeSorted(xss...)
+		}
+	})
+	b.Run("append and sort", func(b *testing.B) {
+		for b.Loop() {
+			sortedSink = slices.Sorted(slices.Values(slices.Concat(xss...)))
+		}
+	})
+}
@@ -0,0 +1,135 @@
+package xslices
+
+import (
+	"context"
+	"time"
+)
+
+// MapUnique runs the mapping function to map a slice to a new slice,
+// calling fn once for each distinct element, and reusing the result for repeated elements.
+func MapUnique[X comparable, Y any](xs []X, fn func(X) Y) []Y {
+	if xs == nil {
+		return nil
+	}
+
+	cache := make(map[X]Y)
+	ys := make([]Y, len(xs))
+	for i := range xs {
+		y, ok := cache[xs[i]]
+		if !ok {
+			y = fn(xs[i])
+			cache[xs[i]] = y
+		}
+		ys[i] = y
+	}
+	return ys
+}
+
+// MapUniqueErr runs the mapping function to map a slice to a new slice,
+// calling fn once for each distinct element, and reusing the result for repeated elements.
+// The mapping function may return an error which stops mapping.
+// The error is returned as an [*IndexError] for the first occurrence of the failed element,
+// with the partially mapped slice, which contains the results for all elements before that index.
+func MapUniqueErr[X comparable, Y any](xs []X, fn func(X) (Y, error)) ([]Y, error) {
+	return MapUniqueCtx(context.Background(), xs, func(_ context.Context, x X) (Y, error) {
+		return fn(x)
+	})
+}
+
+// MapUniqueCtx runs the context-aware mapping function to map a slice to a new slice,
+// calling fn once for each distinct element, and reusing the result for repeated elements.
+// The mapping function may return an error which stops mapping.
+// The error is returned as an [*IndexError] for the first occurrence of the failed element,
+// with the partially mapped slice, which contains the results for all elements before that index.
+// MapUniqueCtx does not explicitly check for context errors, the mapping function is expected to respect and propagate context errors.
+func MapUniqueCtx[X comparable, Y any](ctx context.Context, xs []X, fn func(context.Context, X) (Y, error)) ([]Y, error) {
+	if xs == nil {
+		return nil, nil
+	}
+
+	cache := make(map[X]Y)
+	ys := make([]Y, len(xs))
+	for i := range xs {
+		y, ok := cache[xs[i]]
+		if !ok {
+			var err error
+			y, err = fn(ctx, xs[i])
+			if err != nil {
+				return ys[:i], &IndexError{Index: i, Err: err}
+			}
+			cache[xs[i]] = y
+		}
+		ys[i] = y
+	}
+	return ys, nil
+}
+
+// MapUniqueParallel runs the context-aware mapping function concurrently to map a slice to a new slice,
+// calling fn once for each distinct element, and reusing the result for repeated elements.
+// Since elements are deduplicated before mapping, identical elements never result in concurrent calls.
+//
+// Errors, panics and opts are handled the same as [MapParallel], except that
+// indexes in errors, panics and opts.Observer refer to the first occurrence of the element in xs.
+func MapUniqueParallel[X comparable, Y any](ctx context.Context, xs []X, fn func(context.Context, X) (Y, error), opts ParallelOptions) ([]Y, error) {
+	if xs == nil {
+		return nil, nil
+	}
+
+	var (
+		distinct      []X
+		firstIndex    []int                  // index in xs of the first occurrence of each distinct element.
+		distinctIndex = make([]int, len(xs)) // index in distinct for each element in xs.
+		seen          = make(map[X]int)
+	)
+	for i := range xs {
+		di, ok := seen[xs[i]]
+		if !ok {
+			di = len(distinct)
+			seen[xs[i]] = di
+			distinct = append(distinct, xs[i])
+			firstIndex = append(firstIndex, i)
+		}
+		distinctIndex[i] = di
+	}
+
+	if opts.Observer != nil {
+		opts.Observer = remapObserver{opts.Observer, firstIndex}
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if p, ok := r.(*panicError); ok {
+				p.index = firstIndex[p.index]
+			}
+			panic(r)
+		}
+	}()
+
+	distinctYs, err := MapParallel(ctx, distinct, fn, opts)
+	if err != nil {
+		if indexErr, ok := err.(*IndexError); ok {
+			indexErr.Index = firstIndex[indexErr.Index]
+		}
+		return nil, err
+	}
+
+	ys := make([]Y, len(xs))
+	for i, di := range distinctIndex {
+		ys[i] = distinctYs[di]
+	}
+	return ys, nil
+}
+
+// remapObserver maps the indexes reported to an Observer.
+type remapObserver struct {
+	Observer
+	indexes []int
+}
+
+func (o remapObserver) OnStart(i int) {
+	o.Observer.OnStart(o.indexes[i])
+}
+
+func (o remapObserver) OnDone(i int, dur time.Duration, err error) {
+	o.Observer.OnDone(o.indexes[i], dur, err)
+}
@@ -0,0 +1,156 @@
+package xslices
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// countingAtoi returns a mapping function that records the number of calls for each input.
+func countingAtoi() (func(context.Context, string) (int, error), func() map[string]int) {
+	var (
+		mu    sync.Mutex
+		calls = make(map[string]int)
+	)
+	fn := func(_ context.Context, s string) (int, error) {
+		mu.Lock()
+		calls[s]++
+		mu.Unlock()
+
+		return strconv.Atoi(s)
+	}
+	return fn, func() map[string]int {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls
+	}
+}
+
+func TestMapUnique(t *testing.T) {
+	tests := []struct {
+		name         string
+		in           []string
+		want         []int
+		wantParallel []int
+		wantErr      string
+		wantCalls    map[string]int
+	}{
+		{
+			name:      "nil",
+			in:        nil,
+			want:      nil,
+			wantCalls: map[string]int{},
+		},
+		{
+			name:         "empty",
+			in:           []string{},
+			want:         []int{},
+			wantParallel: []int{},
+			wantCalls:    map[string]int{},
+		},
+		{
+			name:         "repeated elements",
+			in:           []string{"1", "2", "1", "3", "2", "1"},
+			want:         []int{1, 2, 1, 3, 2, 1},
+			wantParallel: []int{1, 2, 1, 3, 2, 1},
+			wantCalls:    map[string]int{"1": 1, "2": 1, "3": 1},
+		},
+		{
+			name:      "err reports first occurrence",
+			in:        []string{"1", "1", "err", "2", "err"},
+			want:      []int{1, 1},
+			wantErr:   "index 2: strconv.Atoi",
+			wantCalls: map[string]int{"1": 1, "err": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == "" {
+				fn, calls := countingAtoi()
+				got := MapUnique(tt.in, func(s string) int {
+					n, _ := fn(context.Background(), s)
+					return n
+				})
+				assertEq(t, tt.want, got)
+				assertEq(t, tt.wantCalls, calls())
+			}
+
+			fn, calls := countingAtoi()
+			got, err := MapUniqueErr(tt.in, func(s string) (int, error) {
+				return fn(context.Background(), s)
+			})
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+			assertEq(t, tt.wantCalls, calls())
+
+			fn, calls = countingAtoi()
+			got, err = MapUniqueCtx(context.Background(), tt.in, fn)
+			assertErr(t, tt.wantErr, err)
+			assertEq(t, tt.want, got)
+			assertEq(t, tt.wantCalls, calls())
+
+			for _, concurrency := range []int{0, 1, 4} {
+				fn, calls := countingAtoi()
+				got, err := MapUniqueParallel(context.Background(), tt.in, fn, ParallelOptions{Concurrency: concurrency})
+				assertErr(t, tt.wantErr, err)
+				assertEq(t, tt.wantParallel, got)
+
+				// Each distinct element is called at most once, even concurrently.
+				for s, n := range calls() {
+					if n != 1 {
+						t.Fatalf("expected 1 call for %q, got %v", s, n)
+					}
+				}
+
+				if tt.wantErr != "" {
+					var indexErr *IndexError
+					assertEq(t, true, errors.As(err, &indexErr))
+					assertEq(t, 2, indexErr.Index)
+				}
+			}
+		})
+	}
+}
+
+func TestMapUniqueParallel_Observer(t *testing.T) {
+	fn, _ := countingAtoi()
+	obs := &recordingObserver{}
+
+	got, err := MapUniqueParallel(context.Background(), []string{"5", "5", "6"}, fn, ParallelOptions{
+		Concurrency: 1,
+		Observer:    obs,
+		Clock:       &frozenClock{},
+	})
+	assertErr(t, "", err)
+	assertEq(t, []int{5, 5, 6}, got)
+
+	// Indexes refer to the first occurrence in xs.
+	assertEq(t, []string{"start 0", "done 0 0s <nil>", "start 2", "done 2 0s <nil>"}, obs.calls)
+}
+
+func TestMapUniqueParallel_Panic(t *testing.T) {
+	errPanic := errors.New("panic error")
+	fn := func(_ context.Context, s string) (int, error) {
+		if s == "b" {
+			panicInWorker(errPanic)
+		}
+		return len(s), nil
+	}
+
+	defer func() {
+		err, ok := recover().(error)
+		if !ok {
+			t.Fatal("expected panic with an error")
+		}
+		assertEq(t, true, errors.Is(err, errPanic))
+
+		// The index refers to the first occurrence of "b" in xs, rather than in the distinct elements.
+		assertErr(t, "panic in index 2: panic error", err)
+	}()
+
+	MapUniqueParallel(context.Background(), []string{"a", "a", "b", "b"}, fn, ParallelOptions{Concurrency: 1})
+	t.Fatal("expected panic")
+}
@@ -0,0 +1,36 @@
+package xslices
+
+// Pair is a pair of values, used by Zip and Unzip.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip returns a slice of pairs of elements at the same index in as and bs.
+// If the slices have different lengths, the extra elements of the longer slice are ignored.
+// If either slice is nil, nil is returned.
+func Zip[A, B any](as []A, bs []B) []Pair[A, B] {
+	if as == nil || bs == nil {
+		return nil
+	}
+
+	pairs := make([]Pair[A, B], min(len(as), len(bs)))
+	for i := range pairs {
+		pairs[i] = Pair[A, B]{as[i], bs[i]}
+	}
+	return pairs
+}
+
+// Unzip splits a slice of pairs into a slice of the first values and a slice of the second values.
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	if pairs == nil {
+		return nil, nil
+	}
+
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i := range pairs {
+		as[i], bs[i] = pairs[i].First, pairs[i].Second
+	}
+	return as, bs
+}
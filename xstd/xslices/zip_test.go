@@ -0,0 +1,58 @@
+package xslices
+
+import "testing"
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		name string
+		as   []int
+		bs   []string
+		want []Pair[int, string]
+	}{
+		{
+			name: "nil",
+			as:   nil,
+			bs:   []string{"a"},
+			want: nil,
+		},
+		{
+			name: "empty",
+			as:   []int{},
+			bs:   []string{},
+			want: []Pair[int, string]{},
+		},
+		{
+			name: "same length",
+			as:   []int{1, 2},
+			bs:   []string{"a", "b"},
+			want: []Pair[int, string]{{1, "a"}, {2, "b"}},
+		},
+		{
+			name: "different lengths",
+			as:   []int{1, 2, 3},
+			bs:   []string{"a"},
+			want: []Pair[int, string]{{1, "a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertEq(t, tt.want, Zip(tt.as, tt.bs))
+		})
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	as, bs := Unzip[int, string](nil)
+	assertEq(t, []int(nil), as)
+	assertEq(t, []string(nil), bs)
+
+	as, bs = Unzip([]Pair[int, string]{{1, "a"}, {2, "b"}})
+	assertEq(t, []int{1, 2}, as)
+	assertEq(t, []string{"a", "b"}, bs)
+
+	// Unzip reverses Zip.
+	as, bs = Unzip(Zip([]int{3, 4}, []string{"c", "d"}))
+	assertEq(t, []int{3, 4}, as)
+	assertEq(t, []string{"c", "d"}, bs)
+}